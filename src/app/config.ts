@@ -1,4 +1,7 @@
 const config = {
   enable_markers: process.env.NEXT_PUBLIC_FEATURE_ENABLE_MARKERS === 'true',
+  // Connect to the tracker over WebSocket at this URL, e.g.
+  // ws://localhost:8080/ws, instead of over Bluetooth
+  tracker_url: process.env.NEXT_PUBLIC_TRACKER_URL,
 }
 export default config;
\ No newline at end of file
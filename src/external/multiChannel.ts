@@ -6,6 +6,8 @@ import AbstractChannel, {
 import WindowChannel from "./windowChannel";
 import PresentationApiChannel from "./presentationApiChannel";
 import { TrackerChannel } from "./trackerChannel";
+import { TrackerWebSocketChannel } from "./trackerWebSocketChannel";
+import config from "@/app/config";
 
 const CHANNEL_PREFERENCES = ["presentationApi", "window"] as const;
 
@@ -47,7 +49,9 @@ export default class MultiChannel extends AbstractChannel {
     });
   }
 
-  public readonly trackerChannel = new TrackerChannel();
+  public readonly trackerChannel: AbstractChannel = config.tracker_url
+    ? new TrackerWebSocketChannel(config.tracker_url)
+    : new TrackerChannel();
 
   constructor() {
     super();
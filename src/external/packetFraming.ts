@@ -0,0 +1,106 @@
+// Packets sent to and from the tracker are split into chunks that each fit in
+// a single GATT write or notification. Every chunk is prefixed with a big
+// endian header of [sequence: u16, chunk index: u32, chunk count: u32].
+// This must be kept in sync with tracker/pkg/ble/framing.go
+const FRAME_HEADER_SIZE = 10;
+
+// The minimum ATT MTU (23) minus the 3 byte ATT header. The tracker replies to
+// reads of its read characteristic with the chunk size for the negotiated MTU.
+export const DEFAULT_CHUNK_SIZE = 20;
+
+// Enough chunks for the largest packet the tracker accepts, 1 MB, at the
+// default chunk size
+const MAX_PACKET_SIZE = 1 << 20;
+const MAX_CHUNK_COUNT = Math.ceil(
+  MAX_PACKET_SIZE / (DEFAULT_CHUNK_SIZE - FRAME_HEADER_SIZE)
+);
+
+const REASSEMBLY_TIMEOUT_MS = 5000;
+
+export function fragmentPacket(
+  sequence: number,
+  data: Uint8Array,
+  chunkSize = DEFAULT_CHUNK_SIZE
+): Array<Uint8Array> {
+  const payloadSize = chunkSize - FRAME_HEADER_SIZE;
+  const count = Math.max(1, Math.ceil(data.length / payloadSize));
+  if (count > MAX_CHUNK_COUNT) {
+    throw new Error(`Packet of ${data.length} bytes is too large to send`);
+  }
+
+  const chunks = new Array<Uint8Array>();
+  for (let i = 0; i < count; i++) {
+    const payload = data.subarray(i * payloadSize, (i + 1) * payloadSize);
+    const chunk = new Uint8Array(FRAME_HEADER_SIZE + payload.length);
+    const view = new DataView(chunk.buffer);
+    view.setUint16(0, sequence & 0xffff);
+    view.setUint32(2, i);
+    view.setUint32(6, count);
+    chunk.set(payload, FRAME_HEADER_SIZE);
+    chunks.push(chunk);
+  }
+  return chunks;
+}
+
+type PartialPacket = {
+  chunks: Array<Uint8Array | undefined>;
+  received: number;
+  updatedAt: number;
+};
+
+export class PacketReassembler {
+  private _partials = new Map<number, PartialPacket>();
+
+  // Returns the full packet once its final chunk has been received
+  add(chunk: Uint8Array): Uint8Array | null {
+    if (chunk.length < FRAME_HEADER_SIZE) {
+      throw new Error(`Chunk of ${chunk.length} bytes is missing its header`);
+    }
+
+    const view = new DataView(chunk.buffer, chunk.byteOffset, chunk.byteLength);
+    const sequence = view.getUint16(0);
+    const index = view.getUint32(2);
+    const count = view.getUint32(6);
+    if (count === 0 || count > MAX_CHUNK_COUNT || index >= count) {
+      throw new Error(`Invalid chunk ${index} of ${count} for packet ${sequence}`);
+    }
+
+    const now = Date.now();
+    this._partials.forEach((partial, seq) => {
+      if (now - partial.updatedAt > REASSEMBLY_TIMEOUT_MS) {
+        console.warn(`Dropping incomplete packet ${seq}`);
+        this._partials.delete(seq);
+      }
+    });
+
+    let partial = this._partials.get(sequence);
+    if (!partial || partial.chunks.length !== count) {
+      partial = { chunks: new Array(count), received: 0, updatedAt: now };
+      this._partials.set(sequence, partial);
+    }
+
+    if (!partial.chunks[index]) {
+      partial.received++;
+    }
+    partial.chunks[index] = chunk.slice(FRAME_HEADER_SIZE);
+    partial.updatedAt = now;
+
+    if (partial.received < count) {
+      return null;
+    }
+    this._partials.delete(sequence);
+
+    const size = partial.chunks.reduce((s, c) => s + c!.length, 0);
+    const data = new Uint8Array(size);
+    let offset = 0;
+    for (const c of partial.chunks) {
+      data.set(c!, offset);
+      offset += c!.length;
+    }
+    return data;
+  }
+
+  reset() {
+    this._partials.clear();
+  }
+}
@@ -1,5 +1,10 @@
 import AbstractChannel, { ChannelState } from "./abstractChannel";
 import { Packet, TrackerGetMarkerLocationResponse } from "@/protos/external";
+import {
+  DEFAULT_CHUNK_SIZE,
+  PacketReassembler,
+  fragmentPacket,
+} from "./packetFraming";
 
 const CONFIG = {
   filters: [
@@ -20,6 +25,9 @@ export class TrackerChannel extends AbstractChannel {
   private _device: BluetoothDevice | null = null;
   private _writeChar: BluetoothRemoteGATTCharacteristic | null = null;
   private _readChar: BluetoothRemoteGATTCharacteristic | null = null;
+  private _reassembler = new PacketReassembler();
+  private _outboundSequence = 0;
+  private _chunkSize = DEFAULT_CHUNK_SIZE;
 
   get state(): ChannelState {
     if (this._connecting) {
@@ -61,6 +69,15 @@ export class TrackerChannel extends AbstractChannel {
 
       this._writeChar = await service.getCharacteristic(CONFIG.writeCharUUID);
       this._readChar = await service.getCharacteristic(CONFIG.readCharUUID);
+
+      // The tracker replies with the chunk size for the MTU it negotiated,
+      // which Web Bluetooth doesn't expose. Read it before listening for
+      // notifications, as reads also raise characteristicvaluechanged.
+      const chunkSize = await this._readChar.readValue();
+      if (chunkSize.byteLength >= 2) {
+        this._chunkSize = chunkSize.getUint16(0);
+      }
+
       this._readChar.addEventListener("characteristicvaluechanged", async (e) => {
         const d = this._readChar?.value;
         if (!d) {
@@ -68,9 +85,13 @@ export class TrackerChannel extends AbstractChannel {
         }
 
         try {
-          await this.processIncomingPacket(
-            Packet.decode(new Uint8Array(d.buffer))
+          const data = this._reassembler.add(
+            new Uint8Array(d.buffer, d.byteOffset, d.byteLength)
           );
+          if (!data) {
+            return;
+          }
+          await this.processIncomingPacket(Packet.decode(data));
         } catch (e) {
           console.warn("Failed to process incoming packet", e);
         }
@@ -94,6 +115,8 @@ export class TrackerChannel extends AbstractChannel {
       this._writeChar = null;
       this._readChar = null;
     }
+    this._reassembler.reset();
+    this._chunkSize = DEFAULT_CHUNK_SIZE;
     this.notifyConnectionStateChange();
   }
 
@@ -104,7 +127,15 @@ export class TrackerChannel extends AbstractChannel {
     }
 
     try {
-      await this._writeChar.writeValueWithoutResponse(Packet.encode(packet).finish());
+      const chunks = fragmentPacket(
+        this._outboundSequence,
+        Packet.encode(packet).finish(),
+        this._chunkSize
+      );
+      this._outboundSequence = (this._outboundSequence + 1) & 0xffff;
+      for (const chunk of chunks) {
+        await this._writeChar.writeValueWithoutResponse(chunk);
+      }
     }
     catch (e) {
       console.error("Failed to send packet", e);
@@ -0,0 +1,89 @@
+import AbstractChannel, { ChannelState } from "./abstractChannel";
+import { Packet } from "@/protos/external";
+
+// Connects to the tracker's /ws endpoint, e.g. when it runs on a development
+// machine without Bluetooth. Each WebSocket message carries a whole packet, so
+// no framing is needed, but requests and responses are dispatched the same way
+// as over Bluetooth.
+export class TrackerWebSocketChannel extends AbstractChannel {
+  private _socket: WebSocket | null = null;
+
+  constructor(private readonly _url: string) {
+    super();
+  }
+
+  get state(): ChannelState {
+    switch (this._socket?.readyState) {
+      case WebSocket.CONNECTING:
+        return ChannelState.CONNECTING;
+      case WebSocket.OPEN:
+        return ChannelState.CONNECTED;
+      case WebSocket.CLOSING:
+        return ChannelState.DISCONNECTING;
+      default:
+        return ChannelState.DISCONNECTED;
+    }
+  }
+
+  get isSupported() {
+    return typeof WebSocket !== "undefined";
+  }
+
+  async connect() {
+    if (this._socket) {
+      return;
+    }
+
+    const socket = new WebSocket(this._url);
+    socket.binaryType = "arraybuffer";
+    this._socket = socket;
+    this.notifyConnectionStateChange();
+
+    socket.addEventListener("open", () => {
+      this.notifyConnectionStateChange();
+    });
+    socket.addEventListener("close", () => {
+      if (this._socket === socket) {
+        this._socket = null;
+      }
+      this.notifyConnectionStateChange();
+    });
+    socket.addEventListener("error", (e) => {
+      console.warn("Tracker WebSocket error", e);
+    });
+    socket.addEventListener("message", async (event: MessageEvent) => {
+      if (!(event.data instanceof ArrayBuffer)) return;
+
+      try {
+        await this.processIncomingPacket(
+          Packet.decode(new Uint8Array(event.data))
+        );
+      } catch (e) {
+        console.warn("Failed to process incoming packet", e);
+      }
+    });
+
+    // Resolve once the connection either opens or fails
+    await new Promise<void>((res) => {
+      socket.addEventListener("open", () => res());
+      socket.addEventListener("close", () => res());
+    });
+  }
+
+  async disconnect() {
+    if (this._socket) {
+      this._socket.close();
+      this._socket = null;
+    }
+    this.notifyConnectionStateChange();
+  }
+
+  async sendOutgoingPacket(packet: Packet) {
+    if (this._socket?.readyState !== WebSocket.OPEN) {
+      console.warn("Failed to send packet. Tracker is not connected");
+      return;
+    }
+
+    this._socket.send(Packet.encode(packet).finish());
+  }
+}
@@ -10,6 +10,55 @@ import { Scene } from "./scene";
 
 export const protobufPackage = "";
 
+/**
+ * Color of a marker's LED. Values are prefixed as enum values share the
+ * package's scope.
+ */
+export enum TrackerMarkerColor {
+  COLOR_UNKNOWN = 0,
+  COLOR_RED = 1,
+  COLOR_GREEN = 2,
+  COLOR_BLUE = 3,
+  UNRECOGNIZED = -1,
+}
+
+export function trackerMarkerColorFromJSON(object: any): TrackerMarkerColor {
+  switch (object) {
+    case 0:
+    case "COLOR_UNKNOWN":
+      return TrackerMarkerColor.COLOR_UNKNOWN;
+    case 1:
+    case "COLOR_RED":
+      return TrackerMarkerColor.COLOR_RED;
+    case 2:
+    case "COLOR_GREEN":
+      return TrackerMarkerColor.COLOR_GREEN;
+    case 3:
+    case "COLOR_BLUE":
+      return TrackerMarkerColor.COLOR_BLUE;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return TrackerMarkerColor.UNRECOGNIZED;
+  }
+}
+
+export function trackerMarkerColorToJSON(object: TrackerMarkerColor): string {
+  switch (object) {
+    case TrackerMarkerColor.COLOR_UNKNOWN:
+      return "COLOR_UNKNOWN";
+    case TrackerMarkerColor.COLOR_RED:
+      return "COLOR_RED";
+    case TrackerMarkerColor.COLOR_GREEN:
+      return "COLOR_GREEN";
+    case TrackerMarkerColor.COLOR_BLUE:
+      return "COLOR_BLUE";
+    case TrackerMarkerColor.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
 export interface Packet {
   requestId: string;
   request?: Request | undefined;
@@ -62,7 +111,31 @@ export interface Request {
     | TrackerGetMarkerLocationRequest
     | undefined;
   /** Don't respond */
-  trackerUpdateMarkerLocationRequest?: TrackerUpdateMarkerLocationRequest | undefined;
+  trackerUpdateMarkerLocationRequest?:
+    | TrackerUpdateMarkerLocationRequest
+    | undefined;
+  /** Respond with AckResponse */
+  trackerStartIntrinsicCalibrationRequest?:
+    | TrackerStartIntrinsicCalibrationRequest
+    | undefined;
+  /** Respond with TrackerGetIntrinsicCalibrationResponse */
+  trackerGetIntrinsicCalibrationRequest?:
+    | TrackerGetIntrinsicCalibrationRequest
+    | undefined;
+  /** Don't respond */
+  trackerMarkerEventsRequest?:
+    | TrackerMarkerEventsRequest
+    | undefined;
+  /** Respond with AckResponse */
+  trackerResyncMarkersRequest?:
+    | TrackerResyncMarkersRequest
+    | undefined;
+  /** Respond with AckResponse */
+  trackerSetDisplayMaskRequest?:
+    | TrackerSetDisplayMaskRequest
+    | undefined;
+  /** Respond with AckResponse */
+  trackerSetDetectionParametersRequest?: TrackerSetDetectionParametersRequest | undefined;
 }
 
 export interface Response {
@@ -76,6 +149,7 @@ export interface Response {
   trackerGetStatusResponse?: TrackerGetStatusResponse | undefined;
   trackerGetCalibrationResponse?: TrackerGetCalibrationResponse | undefined;
   trackerGetMarkerLocationResponse?: TrackerGetMarkerLocationResponse | undefined;
+  trackerGetIntrinsicCalibrationResponse?: TrackerGetIntrinsicCalibrationResponse | undefined;
 }
 
 export interface HelloRequest {
@@ -130,12 +204,15 @@ export interface TrackerGetStatusResponse {
   uuid: string;
   version: string;
   state: TrackerGetStatusResponse_TrackerState;
+  /** Why the camera stopped delivering frames, empty while it is running */
+  cameraError: string;
 }
 
 export enum TrackerGetStatusResponse_TrackerState {
   IDLE = 0,
   CALIBRATING = 1,
   TRACKING = 2,
+  CALIBRATING_INTRINSICS = 3,
   UNRECOGNIZED = -1,
 }
 
@@ -150,6 +227,9 @@ export function trackerGetStatusResponse_TrackerStateFromJSON(object: any): Trac
     case 2:
     case "TRACKING":
       return TrackerGetStatusResponse_TrackerState.TRACKING;
+    case 3:
+    case "CALIBRATING_INTRINSICS":
+      return TrackerGetStatusResponse_TrackerState.CALIBRATING_INTRINSICS;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -165,6 +245,8 @@ export function trackerGetStatusResponse_TrackerStateToJSON(object: TrackerGetSt
       return "CALIBRATING";
     case TrackerGetStatusResponse_TrackerState.TRACKING:
       return "TRACKING";
+    case TrackerGetStatusResponse_TrackerState.CALIBRATING_INTRINSICS:
+      return "CALIBRATING_INTRINSICS";
     case TrackerGetStatusResponse_TrackerState.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -175,7 +257,13 @@ export interface TrackerSetIdleRequest {
 }
 
 export interface TrackerStartCalibrationRequest {
+  /** Positions on the table of the reference markers, at least 4 are required */
   corners: TrackerVector2d[];
+  /**
+   * ArUco ID of the marker displayed at each of the corners. When empty the
+   * markers are numbered 1, 2, 3... in order.
+   */
+  markerIds: number[];
 }
 
 export interface TrackerGetCalibrationRequest {
@@ -183,18 +271,182 @@ export interface TrackerGetCalibrationRequest {
 
 export interface TrackerGetCalibrationResponse {
   foundCorners: number[];
+  /** Pixel location of each of the foundCorners, in the same order */
   cornerLocations: TrackerVector2d[];
+  /**
+   * RMS distance, in table units, between the real corners and the detected
+   * corners mapped through the homography of the last completed calibration.
+   * A homography fits any 4 corners exactly, so this is only meaningful, and
+   * can only fail a calibration, when more than 4 markers are used. With 4,
+   * only the cornerDeviations check applies.
+   */
+  reprojectionError: number;
+  /**
+   * Whether each marker of the last completed calibration, see
+   * calibratedMarkers, agreed with the homography. Markers that didn't were
+   * left out of it.
+   */
+  inliers: boolean[];
+  /**
+   * How much, in pixels, each of the foundCorners has moved over the last
+   * second. Calibration is rejected if a corner isn't steady.
+   */
+  cornerDeviations: number[];
+  result: TrackerGetCalibrationResponse_CalibrationResult;
+  failureReason: string;
+  /**
+   * IDs of the markers the last completed calibration was computed from.
+   * Markers that weren't steady are left out.
+   */
+  calibratedMarkers: number[];
+  /**
+   * Distance, in table units, between each of calibratedMarkers and where its
+   * detected corner is mapped to by the homography, including the markers that
+   * weren't inliers
+   */
+  residuals: number[];
+  /**
+   * The camera's intrinsics changed since the last completed calibration, so
+   * its homography was discarded and the table has to be calibrated again
+   */
+  needsRecalibration: boolean;
+}
+
+export enum TrackerGetCalibrationResponse_CalibrationResult {
+  /** NONE - Calibration hasn't finished since it was started */
+  NONE = 0,
+  PASSED = 1,
+  /**
+   * FAILED - The calibration was rejected and the previous calibration kept, see
+   * failureReason
+   */
+  FAILED = 2,
+  UNRECOGNIZED = -1,
+}
+
+export function trackerGetCalibrationResponse_CalibrationResultFromJSON(
+  object: any,
+): TrackerGetCalibrationResponse_CalibrationResult {
+  switch (object) {
+    case 0:
+    case "NONE":
+      return TrackerGetCalibrationResponse_CalibrationResult.NONE;
+    case 1:
+    case "PASSED":
+      return TrackerGetCalibrationResponse_CalibrationResult.PASSED;
+    case 2:
+    case "FAILED":
+      return TrackerGetCalibrationResponse_CalibrationResult.FAILED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return TrackerGetCalibrationResponse_CalibrationResult.UNRECOGNIZED;
+  }
+}
+
+export function trackerGetCalibrationResponse_CalibrationResultToJSON(
+  object: TrackerGetCalibrationResponse_CalibrationResult,
+): string {
+  switch (object) {
+    case TrackerGetCalibrationResponse_CalibrationResult.NONE:
+      return "NONE";
+    case TrackerGetCalibrationResponse_CalibrationResult.PASSED:
+      return "PASSED";
+    case TrackerGetCalibrationResponse_CalibrationResult.FAILED:
+      return "FAILED";
+    case TrackerGetCalibrationResponse_CalibrationResult.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
 }
 
 export interface TrackerStartTrackingRequest {
   updateRateMs: number;
+  /** Height of the markers' LEDs above the table, in table units */
+  markerHeight: number;
+  /**
+   * How long a marker that can't be seen, e.g. because a hand is over it,
+   * keeps its identity and last location before it is removed. Defaults to 5
+   * seconds.
+   */
+  lostGracePeriodMs: number;
+  /**
+   * Only send the markers that changed in each
+   * TrackerUpdateMarkerLocationRequest, see keyframe
+   */
+  deltaUpdates: boolean;
+  /**
+   * How far, in table units, a marker has to move to be sent in a delta
+   * update. Defaults to 0.1.
+   */
+  deltaTolerance: number;
+  /** Send every marker once every this many updates. Defaults to 20. */
+  keyframeInterval: number;
+  mode: TrackerStartTrackingRequest_TrackingMode;
+  /**
+   * How far, in table units, outside the calibrated table markers are still
+   * tracked. Anything further out, e.g. a lamp or phone beside the table, is
+   * ignored. Defaults to 0.5.
+   */
+  tableMargin: number;
+  /**
+   * Pair green LEDs with a nearby red LED and give them the heading from the
+   * red LED to the green one, for tokens with two LED bases. Off by default, as
+   * single LED tokens are red and green too.
+   */
+  ledPairHeadings: boolean;
+}
+
+export enum TrackerStartTrackingRequest_TrackingMode {
+  /** LED_MARKERS - Track the LEDs in the tokens' bases */
+  LED_MARKERS = 0,
+  /**
+   * ARUCO_TAGS - Track ArUco tags (4x4, IDs 0 to 49) printed on the tokens' bases, which
+   * need no batteries and show which way the token faces
+   */
+  ARUCO_TAGS = 1,
+  UNRECOGNIZED = -1,
+}
+
+export function trackerStartTrackingRequest_TrackingModeFromJSON(
+  object: any,
+): TrackerStartTrackingRequest_TrackingMode {
+  switch (object) {
+    case 0:
+    case "LED_MARKERS":
+      return TrackerStartTrackingRequest_TrackingMode.LED_MARKERS;
+    case 1:
+    case "ARUCO_TAGS":
+      return TrackerStartTrackingRequest_TrackingMode.ARUCO_TAGS;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return TrackerStartTrackingRequest_TrackingMode.UNRECOGNIZED;
+  }
+}
+
+export function trackerStartTrackingRequest_TrackingModeToJSON(
+  object: TrackerStartTrackingRequest_TrackingMode,
+): string {
+  switch (object) {
+    case TrackerStartTrackingRequest_TrackingMode.LED_MARKERS:
+      return "LED_MARKERS";
+    case TrackerStartTrackingRequest_TrackingMode.ARUCO_TAGS:
+      return "ARUCO_TAGS";
+    case TrackerStartTrackingRequest_TrackingMode.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
 }
 
 export interface TrackerGetMarkerLocationRequest {
 }
 
 export interface TrackerGetMarkerLocationResponse {
+  /** Keyed the same way as TrackerUpdateMarkerLocationRequest */
   markerLocations: { [key: number]: TrackerVector2d };
+  markerColors: { [key: number]: TrackerMarkerColor };
+  markers: { [key: number]: TrackerMarker };
 }
 
 export interface TrackerGetMarkerLocationResponse_MarkerLocationsEntry {
@@ -202,8 +454,39 @@ export interface TrackerGetMarkerLocationResponse_MarkerLocationsEntry {
   value: TrackerVector2d | undefined;
 }
 
+export interface TrackerGetMarkerLocationResponse_MarkerColorsEntry {
+  key: number;
+  value: TrackerMarkerColor;
+}
+
+export interface TrackerGetMarkerLocationResponse_MarkersEntry {
+  key: number;
+  value: TrackerMarker | undefined;
+}
+
 export interface TrackerUpdateMarkerLocationRequest {
+  /**
+   * Keys from 256 up are the blink coded ID of the marker's token, or the ID of
+   * its ArUco tag, plus 256, and stay the same whenever that token is seen.
+   * Other markers are numbered in the order they were found.
+   */
   markerLocations: { [key: number]: TrackerVector2d };
+  /** Color of each marker's LED, with the same keys as markerLocations */
+  markerColors: { [key: number]: TrackerMarkerColor };
+  /** Everything known about each marker, with the same keys as markerLocations */
+  markers: { [key: number]: TrackerMarker };
+  /**
+   * Increases by one with every update, so a client can tell when it missed
+   * one and send a TrackerResyncMarkersRequest
+   */
+  sequence: number;
+  /**
+   * Keyframes include every marker. Otherwise, in delta mode, only markers that
+   * changed since they were last sent are included, and removedKeys lists the
+   * markers that are gone. Updates are skipped when nothing changed.
+   */
+  keyframe: boolean;
+  removedKeys: number[];
 }
 
 export interface TrackerUpdateMarkerLocationRequest_MarkerLocationsEntry {
@@ -211,6 +494,261 @@ export interface TrackerUpdateMarkerLocationRequest_MarkerLocationsEntry {
   value: TrackerVector2d | undefined;
 }
 
+export interface TrackerUpdateMarkerLocationRequest_MarkerColorsEntry {
+  key: number;
+  value: TrackerMarkerColor;
+}
+
+export interface TrackerUpdateMarkerLocationRequest_MarkersEntry {
+  key: number;
+  value: TrackerMarker | undefined;
+}
+
+/** Asks for the next TrackerUpdateMarkerLocationRequest to be a keyframe */
+export interface TrackerResyncMarkersRequest {
+}
+
+/**
+ * Tells the tracker what the table is displaying, so that bright parts of the
+ * scene, e.g. lava or fire, aren't tracked as markers. Send it whenever the
+ * displayed scene changes.
+ */
+export interface TrackerSetDisplayMaskRequest {
+  /**
+   * PNG or JPEG of what the table displays, covering the table from
+   * displayOrigin to displayOrigin + displaySize in table units. New markers
+   * aren't tracked where it shows something that looks like an LED of their
+   * color. Leave empty to stop masking displayed content.
+   */
+  displayImage: Uint8Array;
+  displayOrigin: TrackerVector2d | undefined;
+  displaySize:
+    | TrackerVector2d
+    | undefined;
+  /** Areas of the table where markers are never tracked */
+  exclusionPolygons: TrackerPolygon[];
+}
+
+/**
+ * Tunes how LEDs are picked out of the camera's frames, e.g. for the room's
+ * lighting. It takes effect straight away, even while tracking. Fields left at
+ * zero are set to their defaults.
+ */
+export interface TrackerSetDetectionParametersRequest {
+  /** Lowest brightness, from 0 to 255, of an LED's pixels. Defaults to 120. */
+  minBrightness: number;
+  /**
+   * Pixels have to be adaptiveOffset brighter than the mean of the
+   * adaptiveBlockSize pixels square around them. Default to 40 and 41.
+   */
+  adaptiveOffset: number;
+  adaptiveBlockSize: number;
+  /**
+   * The background model learns what the table looks like without markers,
+   * taking in backgroundLearningRate of each frame, from 0 to 1, and pixels
+   * have to be foregroundThreshold brighter than it. Default to 0.02 and 40.
+   */
+  backgroundLearningRate: number;
+  foregroundThreshold: number;
+  disableBackgroundModel: boolean;
+  /** Forget the learned background, e.g. after the camera has been moved */
+  resetBackground: boolean;
+}
+
+/** Polygon on the table, in table units */
+export interface TrackerPolygon {
+  vertices: TrackerVector2d[];
+}
+
+export interface TrackerMarker {
+  /** Position on the table, and how far it moves across the table per second */
+  location: TrackerVector2d | undefined;
+  velocity:
+    | TrackerVector2d
+    | undefined;
+  /** Sub-pixel position of the marker's LED in the camera's image */
+  pixel: TrackerVector2d | undefined;
+  color: TrackerMarkerColor;
+  /**
+   * Area of the LED's blob in pixels, how close it is to a circle from 0 to 1
+   * and its mean brightness from 0 to 255
+   */
+  area: number;
+  circularity: number;
+  brightness: number;
+  /**
+   * The marker can't currently be seen, and location is where it was last
+   * seen
+   */
+  lost: boolean;
+  /**
+   * Which way the token faces on the table, in radians from the table's x
+   * axis towards its y axis. Only set if hasHeading is.
+   */
+  heading: number;
+  hasHeading: boolean;
+  /**
+   * How sure the tracker is of the heading, from 0 to 1. It drops while the
+   * heading can't be measured, until the heading is dropped.
+   */
+  headingConfidence: number;
+  headingSource: TrackerMarker_HeadingSource;
+  /** Key of the other LED of a two LED base, or 0 */
+  pairedKey: number;
+}
+
+export enum TrackerMarker_HeadingSource {
+  NONE = 0,
+  /** TAG - The direction of the top edge of the token's ArUco tag */
+  TAG = 1,
+  /**
+   * LED_PAIR - The direction from the back (red) LED of a two LED base to its front
+   * (green) LED, when tracking with ledPairHeadings. Both LEDs are reported,
+   * see pairedKey.
+   */
+  LED_PAIR = 2,
+  /**
+   * ELONGATION - The long axis of an elongated blob, e.g. a light bar. Which end is the
+   * front can't be told, so it may be off by half a turn.
+   */
+  ELONGATION = 3,
+  UNRECOGNIZED = -1,
+}
+
+export function trackerMarker_HeadingSourceFromJSON(object: any): TrackerMarker_HeadingSource {
+  switch (object) {
+    case 0:
+    case "NONE":
+      return TrackerMarker_HeadingSource.NONE;
+    case 1:
+    case "TAG":
+      return TrackerMarker_HeadingSource.TAG;
+    case 2:
+    case "LED_PAIR":
+      return TrackerMarker_HeadingSource.LED_PAIR;
+    case 3:
+    case "ELONGATION":
+      return TrackerMarker_HeadingSource.ELONGATION;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return TrackerMarker_HeadingSource.UNRECOGNIZED;
+  }
+}
+
+export function trackerMarker_HeadingSourceToJSON(object: TrackerMarker_HeadingSource): string {
+  switch (object) {
+    case TrackerMarker_HeadingSource.NONE:
+      return "NONE";
+    case TrackerMarker_HeadingSource.TAG:
+      return "TAG";
+    case TrackerMarker_HeadingSource.LED_PAIR:
+      return "LED_PAIR";
+    case TrackerMarker_HeadingSource.ELONGATION:
+      return "ELONGATION";
+    case TrackerMarker_HeadingSource.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+/**
+ * Sent while tracking whenever markers change, alongside the periodic
+ * TrackerUpdateMarkerLocationRequest snapshots
+ */
+export interface TrackerMarkerEventsRequest {
+  events: TrackerMarkerEvent[];
+}
+
+export interface TrackerMarkerEvent {
+  type: TrackerMarkerEvent_EventType;
+  /** Keyed the same way as TrackerUpdateMarkerLocationRequest */
+  key: number;
+  marker: TrackerMarker | undefined;
+}
+
+export enum TrackerMarkerEvent_EventType {
+  /** APPEARED - A new marker has been seen for long enough to be trusted */
+  APPEARED = 0,
+  /** MOVED - The marker has moved far enough since it appeared or last moved */
+  MOVED = 1,
+  /**
+   * LOST - The marker can't be seen, it keeps its key until it is recovered or
+   * removed
+   */
+  LOST = 2,
+  RECOVERED = 3,
+  /**
+   * REMOVED - The marker is gone, or its key has changed because its token ID was
+   * decoded, in which case it appears again under the new key
+   */
+  REMOVED = 4,
+  UNRECOGNIZED = -1,
+}
+
+export function trackerMarkerEvent_EventTypeFromJSON(object: any): TrackerMarkerEvent_EventType {
+  switch (object) {
+    case 0:
+    case "APPEARED":
+      return TrackerMarkerEvent_EventType.APPEARED;
+    case 1:
+    case "MOVED":
+      return TrackerMarkerEvent_EventType.MOVED;
+    case 2:
+    case "LOST":
+      return TrackerMarkerEvent_EventType.LOST;
+    case 3:
+    case "RECOVERED":
+      return TrackerMarkerEvent_EventType.RECOVERED;
+    case 4:
+    case "REMOVED":
+      return TrackerMarkerEvent_EventType.REMOVED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return TrackerMarkerEvent_EventType.UNRECOGNIZED;
+  }
+}
+
+export function trackerMarkerEvent_EventTypeToJSON(object: TrackerMarkerEvent_EventType): string {
+  switch (object) {
+    case TrackerMarkerEvent_EventType.APPEARED:
+      return "APPEARED";
+    case TrackerMarkerEvent_EventType.MOVED:
+      return "MOVED";
+    case TrackerMarkerEvent_EventType.LOST:
+      return "LOST";
+    case TrackerMarkerEvent_EventType.RECOVERED:
+      return "RECOVERED";
+    case TrackerMarkerEvent_EventType.REMOVED:
+      return "REMOVED";
+    case TrackerMarkerEvent_EventType.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+/**
+ * Captures views of a checkerboard until TrackerSetIdleRequest is sent, then
+ * calibrates the camera's lens from them
+ */
+export interface TrackerStartIntrinsicCalibrationRequest {
+  /** Number of inner corners along each side of the checkerboard */
+  columns: number;
+  rows: number;
+  squareSize: number;
+}
+
+export interface TrackerGetIntrinsicCalibrationRequest {
+}
+
+export interface TrackerGetIntrinsicCalibrationResponse {
+  calibrated: boolean;
+  reprojectionError: number;
+  viewsCaptured: number;
+  viewsRequired: number;
+}
+
 function createBasePacket(): Packet {
   return { requestId: "", request: undefined, response: undefined };
 }
@@ -318,6 +856,12 @@ function createBaseRequest(): Request {
     trackerStartTrackingRequest: undefined,
     trackerGetMarkerLocationRequest: undefined,
     trackerUpdateMarkerLocationRequest: undefined,
+    trackerStartIntrinsicCalibrationRequest: undefined,
+    trackerGetIntrinsicCalibrationRequest: undefined,
+    trackerMarkerEventsRequest: undefined,
+    trackerResyncMarkersRequest: undefined,
+    trackerSetDisplayMaskRequest: undefined,
+    trackerSetDetectionParametersRequest: undefined,
   };
 }
 
@@ -361,6 +905,33 @@ export const Request = {
       TrackerUpdateMarkerLocationRequest.encode(message.trackerUpdateMarkerLocationRequest, writer.uint32(130).fork())
         .ldelim();
     }
+    if (message.trackerStartIntrinsicCalibrationRequest !== undefined) {
+      TrackerStartIntrinsicCalibrationRequest.encode(
+        message.trackerStartIntrinsicCalibrationRequest,
+        writer.uint32(138).fork(),
+      ).ldelim();
+    }
+    if (message.trackerGetIntrinsicCalibrationRequest !== undefined) {
+      TrackerGetIntrinsicCalibrationRequest.encode(
+        message.trackerGetIntrinsicCalibrationRequest,
+        writer.uint32(146).fork(),
+      ).ldelim();
+    }
+    if (message.trackerMarkerEventsRequest !== undefined) {
+      TrackerMarkerEventsRequest.encode(message.trackerMarkerEventsRequest, writer.uint32(154).fork()).ldelim();
+    }
+    if (message.trackerResyncMarkersRequest !== undefined) {
+      TrackerResyncMarkersRequest.encode(message.trackerResyncMarkersRequest, writer.uint32(162).fork()).ldelim();
+    }
+    if (message.trackerSetDisplayMaskRequest !== undefined) {
+      TrackerSetDisplayMaskRequest.encode(message.trackerSetDisplayMaskRequest, writer.uint32(170).fork()).ldelim();
+    }
+    if (message.trackerSetDetectionParametersRequest !== undefined) {
+      TrackerSetDetectionParametersRequest.encode(
+        message.trackerSetDetectionParametersRequest,
+        writer.uint32(178).fork(),
+      ).ldelim();
+    }
     return writer;
   },
 
@@ -458,6 +1029,57 @@ export const Request = {
             reader.uint32(),
           );
           continue;
+        case 17:
+          if (tag !== 138) {
+            break;
+          }
+
+          message.trackerStartIntrinsicCalibrationRequest = TrackerStartIntrinsicCalibrationRequest.decode(
+            reader,
+            reader.uint32(),
+          );
+          continue;
+        case 18:
+          if (tag !== 146) {
+            break;
+          }
+
+          message.trackerGetIntrinsicCalibrationRequest = TrackerGetIntrinsicCalibrationRequest.decode(
+            reader,
+            reader.uint32(),
+          );
+          continue;
+        case 19:
+          if (tag !== 154) {
+            break;
+          }
+
+          message.trackerMarkerEventsRequest = TrackerMarkerEventsRequest.decode(reader, reader.uint32());
+          continue;
+        case 20:
+          if (tag !== 162) {
+            break;
+          }
+
+          message.trackerResyncMarkersRequest = TrackerResyncMarkersRequest.decode(reader, reader.uint32());
+          continue;
+        case 21:
+          if (tag !== 170) {
+            break;
+          }
+
+          message.trackerSetDisplayMaskRequest = TrackerSetDisplayMaskRequest.decode(reader, reader.uint32());
+          continue;
+        case 22:
+          if (tag !== 178) {
+            break;
+          }
+
+          message.trackerSetDetectionParametersRequest = TrackerSetDetectionParametersRequest.decode(
+            reader,
+            reader.uint32(),
+          );
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -501,6 +1123,24 @@ export const Request = {
       trackerUpdateMarkerLocationRequest: isSet(object.trackerUpdateMarkerLocationRequest)
         ? TrackerUpdateMarkerLocationRequest.fromJSON(object.trackerUpdateMarkerLocationRequest)
         : undefined,
+      trackerStartIntrinsicCalibrationRequest: isSet(object.trackerStartIntrinsicCalibrationRequest)
+        ? TrackerStartIntrinsicCalibrationRequest.fromJSON(object.trackerStartIntrinsicCalibrationRequest)
+        : undefined,
+      trackerGetIntrinsicCalibrationRequest: isSet(object.trackerGetIntrinsicCalibrationRequest)
+        ? TrackerGetIntrinsicCalibrationRequest.fromJSON(object.trackerGetIntrinsicCalibrationRequest)
+        : undefined,
+      trackerMarkerEventsRequest: isSet(object.trackerMarkerEventsRequest)
+        ? TrackerMarkerEventsRequest.fromJSON(object.trackerMarkerEventsRequest)
+        : undefined,
+      trackerResyncMarkersRequest: isSet(object.trackerResyncMarkersRequest)
+        ? TrackerResyncMarkersRequest.fromJSON(object.trackerResyncMarkersRequest)
+        : undefined,
+      trackerSetDisplayMaskRequest: isSet(object.trackerSetDisplayMaskRequest)
+        ? TrackerSetDisplayMaskRequest.fromJSON(object.trackerSetDisplayMaskRequest)
+        : undefined,
+      trackerSetDetectionParametersRequest: isSet(object.trackerSetDetectionParametersRequest)
+        ? TrackerSetDetectionParametersRequest.fromJSON(object.trackerSetDetectionParametersRequest)
+        : undefined,
     };
   },
 
@@ -548,6 +1188,30 @@ export const Request = {
         message.trackerUpdateMarkerLocationRequest,
       );
     }
+    if (message.trackerStartIntrinsicCalibrationRequest !== undefined) {
+      obj.trackerStartIntrinsicCalibrationRequest = TrackerStartIntrinsicCalibrationRequest.toJSON(
+        message.trackerStartIntrinsicCalibrationRequest,
+      );
+    }
+    if (message.trackerGetIntrinsicCalibrationRequest !== undefined) {
+      obj.trackerGetIntrinsicCalibrationRequest = TrackerGetIntrinsicCalibrationRequest.toJSON(
+        message.trackerGetIntrinsicCalibrationRequest,
+      );
+    }
+    if (message.trackerMarkerEventsRequest !== undefined) {
+      obj.trackerMarkerEventsRequest = TrackerMarkerEventsRequest.toJSON(message.trackerMarkerEventsRequest);
+    }
+    if (message.trackerResyncMarkersRequest !== undefined) {
+      obj.trackerResyncMarkersRequest = TrackerResyncMarkersRequest.toJSON(message.trackerResyncMarkersRequest);
+    }
+    if (message.trackerSetDisplayMaskRequest !== undefined) {
+      obj.trackerSetDisplayMaskRequest = TrackerSetDisplayMaskRequest.toJSON(message.trackerSetDisplayMaskRequest);
+    }
+    if (message.trackerSetDetectionParametersRequest !== undefined) {
+      obj.trackerSetDetectionParametersRequest = TrackerSetDetectionParametersRequest.toJSON(
+        message.trackerSetDetectionParametersRequest,
+      );
+    }
     return obj;
   },
 
@@ -601,6 +1265,33 @@ export const Request = {
       (object.trackerUpdateMarkerLocationRequest !== undefined && object.trackerUpdateMarkerLocationRequest !== null)
         ? TrackerUpdateMarkerLocationRequest.fromPartial(object.trackerUpdateMarkerLocationRequest)
         : undefined;
+    message.trackerStartIntrinsicCalibrationRequest =
+      (object.trackerStartIntrinsicCalibrationRequest !== undefined
+        && object.trackerStartIntrinsicCalibrationRequest !== null)
+        ? TrackerStartIntrinsicCalibrationRequest.fromPartial(object.trackerStartIntrinsicCalibrationRequest)
+        : undefined;
+    message.trackerGetIntrinsicCalibrationRequest =
+      (object.trackerGetIntrinsicCalibrationRequest !== undefined
+        && object.trackerGetIntrinsicCalibrationRequest !== null)
+        ? TrackerGetIntrinsicCalibrationRequest.fromPartial(object.trackerGetIntrinsicCalibrationRequest)
+        : undefined;
+    message.trackerMarkerEventsRequest =
+      (object.trackerMarkerEventsRequest !== undefined && object.trackerMarkerEventsRequest !== null)
+        ? TrackerMarkerEventsRequest.fromPartial(object.trackerMarkerEventsRequest)
+        : undefined;
+    message.trackerResyncMarkersRequest =
+      (object.trackerResyncMarkersRequest !== undefined && object.trackerResyncMarkersRequest !== null)
+        ? TrackerResyncMarkersRequest.fromPartial(object.trackerResyncMarkersRequest)
+        : undefined;
+    message.trackerSetDisplayMaskRequest =
+      (object.trackerSetDisplayMaskRequest !== undefined && object.trackerSetDisplayMaskRequest !== null)
+        ? TrackerSetDisplayMaskRequest.fromPartial(object.trackerSetDisplayMaskRequest)
+        : undefined;
+    message.trackerSetDetectionParametersRequest =
+      (object.trackerSetDetectionParametersRequest !== undefined
+        && object.trackerSetDetectionParametersRequest !== null)
+        ? TrackerSetDetectionParametersRequest.fromPartial(object.trackerSetDetectionParametersRequest)
+        : undefined;
     return message;
   },
 };
@@ -614,6 +1305,7 @@ function createBaseResponse(): Response {
     trackerGetStatusResponse: undefined,
     trackerGetCalibrationResponse: undefined,
     trackerGetMarkerLocationResponse: undefined,
+    trackerGetIntrinsicCalibrationResponse: undefined,
   };
 }
 
@@ -641,6 +1333,12 @@ export const Response = {
       TrackerGetMarkerLocationResponse.encode(message.trackerGetMarkerLocationResponse, writer.uint32(98).fork())
         .ldelim();
     }
+    if (message.trackerGetIntrinsicCalibrationResponse !== undefined) {
+      TrackerGetIntrinsicCalibrationResponse.encode(
+        message.trackerGetIntrinsicCalibrationResponse,
+        writer.uint32(106).fork(),
+      ).ldelim();
+    }
     return writer;
   },
 
@@ -700,6 +1398,16 @@ export const Response = {
 
           message.trackerGetMarkerLocationResponse = TrackerGetMarkerLocationResponse.decode(reader, reader.uint32());
           continue;
+        case 13:
+          if (tag !== 106) {
+            break;
+          }
+
+          message.trackerGetIntrinsicCalibrationResponse = TrackerGetIntrinsicCalibrationResponse.decode(
+            reader,
+            reader.uint32(),
+          );
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -728,6 +1436,9 @@ export const Response = {
       trackerGetMarkerLocationResponse: isSet(object.trackerGetMarkerLocationResponse)
         ? TrackerGetMarkerLocationResponse.fromJSON(object.trackerGetMarkerLocationResponse)
         : undefined,
+      trackerGetIntrinsicCalibrationResponse: isSet(object.trackerGetIntrinsicCalibrationResponse)
+        ? TrackerGetIntrinsicCalibrationResponse.fromJSON(object.trackerGetIntrinsicCalibrationResponse)
+        : undefined,
     };
   },
 
@@ -756,6 +1467,11 @@ export const Response = {
         message.trackerGetMarkerLocationResponse,
       );
     }
+    if (message.trackerGetIntrinsicCalibrationResponse !== undefined) {
+      obj.trackerGetIntrinsicCalibrationResponse = TrackerGetIntrinsicCalibrationResponse.toJSON(
+        message.trackerGetIntrinsicCalibrationResponse,
+      );
+    }
     return obj;
   },
 
@@ -790,6 +1506,11 @@ export const Response = {
       (object.trackerGetMarkerLocationResponse !== undefined && object.trackerGetMarkerLocationResponse !== null)
         ? TrackerGetMarkerLocationResponse.fromPartial(object.trackerGetMarkerLocationResponse)
         : undefined;
+    message.trackerGetIntrinsicCalibrationResponse =
+      (object.trackerGetIntrinsicCalibrationResponse !== undefined
+        && object.trackerGetIntrinsicCalibrationResponse !== null)
+        ? TrackerGetIntrinsicCalibrationResponse.fromPartial(object.trackerGetIntrinsicCalibrationResponse)
+        : undefined;
     return message;
   },
 };
@@ -1502,7 +2223,7 @@ export const TrackerVector2d = {
 };
 
 function createBaseTrackerGetStatusResponse(): TrackerGetStatusResponse {
-  return { uuid: "", version: "", state: 0 };
+  return { uuid: "", version: "", state: 0, cameraError: "" };
 }
 
 export const TrackerGetStatusResponse = {
@@ -1516,6 +2237,9 @@ export const TrackerGetStatusResponse = {
     if (message.state !== 0) {
       writer.uint32(40).int32(message.state);
     }
+    if (message.cameraError !== "") {
+      writer.uint32(50).string(message.cameraError);
+    }
     return writer;
   },
 
@@ -1547,6 +2271,13 @@ export const TrackerGetStatusResponse = {
 
           message.state = reader.int32() as any;
           continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.cameraError = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1561,6 +2292,7 @@ export const TrackerGetStatusResponse = {
       uuid: isSet(object.uuid) ? globalThis.String(object.uuid) : "",
       version: isSet(object.version) ? globalThis.String(object.version) : "",
       state: isSet(object.state) ? trackerGetStatusResponse_TrackerStateFromJSON(object.state) : 0,
+      cameraError: isSet(object.cameraError) ? globalThis.String(object.cameraError) : "",
     };
   },
 
@@ -1575,6 +2307,9 @@ export const TrackerGetStatusResponse = {
     if (message.state !== 0) {
       obj.state = trackerGetStatusResponse_TrackerStateToJSON(message.state);
     }
+    if (message.cameraError !== "") {
+      obj.cameraError = message.cameraError;
+    }
     return obj;
   },
 
@@ -1586,6 +2321,7 @@ export const TrackerGetStatusResponse = {
     message.uuid = object.uuid ?? "";
     message.version = object.version ?? "";
     message.state = object.state ?? 0;
+    message.cameraError = object.cameraError ?? "";
     return message;
   },
 };
@@ -1634,7 +2370,7 @@ export const TrackerSetIdleRequest = {
 };
 
 function createBaseTrackerStartCalibrationRequest(): TrackerStartCalibrationRequest {
-  return { corners: [] };
+  return { corners: [], markerIds: [] };
 }
 
 export const TrackerStartCalibrationRequest = {
@@ -1642,6 +2378,11 @@ export const TrackerStartCalibrationRequest = {
     for (const v of message.corners) {
       TrackerVector2d.encode(v!, writer.uint32(10).fork()).ldelim();
     }
+    writer.uint32(18).fork();
+    for (const v of message.markerIds) {
+      writer.int32(v);
+    }
+    writer.ldelim();
     return writer;
   },
 
@@ -1659,6 +2400,23 @@ export const TrackerStartCalibrationRequest = {
 
           message.corners.push(TrackerVector2d.decode(reader, reader.uint32()));
           continue;
+        case 2:
+          if (tag === 16) {
+            message.markerIds.push(reader.int32());
+
+            continue;
+          }
+
+          if (tag === 18) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.markerIds.push(reader.int32());
+            }
+
+            continue;
+          }
+
+          break;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1673,6 +2431,9 @@ export const TrackerStartCalibrationRequest = {
       corners: globalThis.Array.isArray(object?.corners)
         ? object.corners.map((e: any) => TrackerVector2d.fromJSON(e))
         : [],
+      markerIds: globalThis.Array.isArray(object?.markerIds)
+        ? object.markerIds.map((e: any) => globalThis.Number(e))
+        : [],
     };
   },
 
@@ -1681,6 +2442,9 @@ export const TrackerStartCalibrationRequest = {
     if (message.corners?.length) {
       obj.corners = message.corners.map((e) => TrackerVector2d.toJSON(e));
     }
+    if (message.markerIds?.length) {
+      obj.markerIds = message.markerIds.map((e) => Math.round(e));
+    }
     return obj;
   },
 
@@ -1692,6 +2456,7 @@ export const TrackerStartCalibrationRequest = {
   ): TrackerStartCalibrationRequest {
     const message = createBaseTrackerStartCalibrationRequest();
     message.corners = object.corners?.map((e) => TrackerVector2d.fromPartial(e)) || [];
+    message.markerIds = object.markerIds?.map((e) => e) || [];
     return message;
   },
 };
@@ -1740,7 +2505,18 @@ export const TrackerGetCalibrationRequest = {
 };
 
 function createBaseTrackerGetCalibrationResponse(): TrackerGetCalibrationResponse {
-  return { foundCorners: [], cornerLocations: [] };
+  return {
+    foundCorners: [],
+    cornerLocations: [],
+    reprojectionError: 0,
+    inliers: [],
+    cornerDeviations: [],
+    result: 0,
+    failureReason: "",
+    calibratedMarkers: [],
+    residuals: [],
+    needsRecalibration: false,
+  };
 }
 
 export const TrackerGetCalibrationResponse = {
@@ -1753,18 +2529,50 @@ export const TrackerGetCalibrationResponse = {
     for (const v of message.cornerLocations) {
       TrackerVector2d.encode(v!, writer.uint32(18).fork()).ldelim();
     }
-    return writer;
-  },
-
-  decode(input: _m0.Reader | Uint8Array, length?: number): TrackerGetCalibrationResponse {
-    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
-    let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseTrackerGetCalibrationResponse();
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-        case 1:
-          if (tag === 8) {
+    if (message.reprojectionError !== 0) {
+      writer.uint32(25).double(message.reprojectionError);
+    }
+    writer.uint32(34).fork();
+    for (const v of message.inliers) {
+      writer.bool(v);
+    }
+    writer.ldelim();
+    writer.uint32(42).fork();
+    for (const v of message.cornerDeviations) {
+      writer.double(v);
+    }
+    writer.ldelim();
+    if (message.result !== 0) {
+      writer.uint32(48).int32(message.result);
+    }
+    if (message.failureReason !== "") {
+      writer.uint32(58).string(message.failureReason);
+    }
+    writer.uint32(66).fork();
+    for (const v of message.calibratedMarkers) {
+      writer.int32(v);
+    }
+    writer.ldelim();
+    writer.uint32(74).fork();
+    for (const v of message.residuals) {
+      writer.double(v);
+    }
+    writer.ldelim();
+    if (message.needsRecalibration !== false) {
+      writer.uint32(80).bool(message.needsRecalibration);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): TrackerGetCalibrationResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTrackerGetCalibrationResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag === 8) {
             message.foundCorners.push(reader.int32());
 
             continue;
@@ -1787,6 +2595,102 @@ export const TrackerGetCalibrationResponse = {
 
           message.cornerLocations.push(TrackerVector2d.decode(reader, reader.uint32()));
           continue;
+        case 3:
+          if (tag !== 25) {
+            break;
+          }
+
+          message.reprojectionError = reader.double();
+          continue;
+        case 4:
+          if (tag === 32) {
+            message.inliers.push(reader.bool());
+
+            continue;
+          }
+
+          if (tag === 34) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.inliers.push(reader.bool());
+            }
+
+            continue;
+          }
+
+          break;
+        case 5:
+          if (tag === 41) {
+            message.cornerDeviations.push(reader.double());
+
+            continue;
+          }
+
+          if (tag === 42) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.cornerDeviations.push(reader.double());
+            }
+
+            continue;
+          }
+
+          break;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.result = reader.int32() as any;
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.failureReason = reader.string();
+          continue;
+        case 8:
+          if (tag === 64) {
+            message.calibratedMarkers.push(reader.int32());
+
+            continue;
+          }
+
+          if (tag === 66) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.calibratedMarkers.push(reader.int32());
+            }
+
+            continue;
+          }
+
+          break;
+        case 9:
+          if (tag === 73) {
+            message.residuals.push(reader.double());
+
+            continue;
+          }
+
+          if (tag === 74) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.residuals.push(reader.double());
+            }
+
+            continue;
+          }
+
+          break;
+        case 10:
+          if (tag !== 80) {
+            break;
+          }
+
+          message.needsRecalibration = reader.bool();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1804,6 +2708,20 @@ export const TrackerGetCalibrationResponse = {
       cornerLocations: globalThis.Array.isArray(object?.cornerLocations)
         ? object.cornerLocations.map((e: any) => TrackerVector2d.fromJSON(e))
         : [],
+      reprojectionError: isSet(object.reprojectionError) ? globalThis.Number(object.reprojectionError) : 0,
+      inliers: globalThis.Array.isArray(object?.inliers) ? object.inliers.map((e: any) => globalThis.Boolean(e)) : [],
+      cornerDeviations: globalThis.Array.isArray(object?.cornerDeviations)
+        ? object.cornerDeviations.map((e: any) => globalThis.Number(e))
+        : [],
+      result: isSet(object.result) ? trackerGetCalibrationResponse_CalibrationResultFromJSON(object.result) : 0,
+      failureReason: isSet(object.failureReason) ? globalThis.String(object.failureReason) : "",
+      calibratedMarkers: globalThis.Array.isArray(object?.calibratedMarkers)
+        ? object.calibratedMarkers.map((e: any) => globalThis.Number(e))
+        : [],
+      residuals: globalThis.Array.isArray(object?.residuals)
+        ? object.residuals.map((e: any) => globalThis.Number(e))
+        : [],
+      needsRecalibration: isSet(object.needsRecalibration) ? globalThis.Boolean(object.needsRecalibration) : false,
     };
   },
 
@@ -1815,6 +2733,30 @@ export const TrackerGetCalibrationResponse = {
     if (message.cornerLocations?.length) {
       obj.cornerLocations = message.cornerLocations.map((e) => TrackerVector2d.toJSON(e));
     }
+    if (message.reprojectionError !== 0) {
+      obj.reprojectionError = message.reprojectionError;
+    }
+    if (message.inliers?.length) {
+      obj.inliers = message.inliers;
+    }
+    if (message.cornerDeviations?.length) {
+      obj.cornerDeviations = message.cornerDeviations;
+    }
+    if (message.result !== 0) {
+      obj.result = trackerGetCalibrationResponse_CalibrationResultToJSON(message.result);
+    }
+    if (message.failureReason !== "") {
+      obj.failureReason = message.failureReason;
+    }
+    if (message.calibratedMarkers?.length) {
+      obj.calibratedMarkers = message.calibratedMarkers.map((e) => Math.round(e));
+    }
+    if (message.residuals?.length) {
+      obj.residuals = message.residuals;
+    }
+    if (message.needsRecalibration !== false) {
+      obj.needsRecalibration = message.needsRecalibration;
+    }
     return obj;
   },
 
@@ -1827,12 +2769,30 @@ export const TrackerGetCalibrationResponse = {
     const message = createBaseTrackerGetCalibrationResponse();
     message.foundCorners = object.foundCorners?.map((e) => e) || [];
     message.cornerLocations = object.cornerLocations?.map((e) => TrackerVector2d.fromPartial(e)) || [];
+    message.reprojectionError = object.reprojectionError ?? 0;
+    message.inliers = object.inliers?.map((e) => e) || [];
+    message.cornerDeviations = object.cornerDeviations?.map((e) => e) || [];
+    message.result = object.result ?? 0;
+    message.failureReason = object.failureReason ?? "";
+    message.calibratedMarkers = object.calibratedMarkers?.map((e) => e) || [];
+    message.residuals = object.residuals?.map((e) => e) || [];
+    message.needsRecalibration = object.needsRecalibration ?? false;
     return message;
   },
 };
 
 function createBaseTrackerStartTrackingRequest(): TrackerStartTrackingRequest {
-  return { updateRateMs: 0 };
+  return {
+    updateRateMs: 0,
+    markerHeight: 0,
+    lostGracePeriodMs: 0,
+    deltaUpdates: false,
+    deltaTolerance: 0,
+    keyframeInterval: 0,
+    mode: 0,
+    tableMargin: 0,
+    ledPairHeadings: false,
+  };
 }
 
 export const TrackerStartTrackingRequest = {
@@ -1840,6 +2800,30 @@ export const TrackerStartTrackingRequest = {
     if (message.updateRateMs !== 0) {
       writer.uint32(13).float(message.updateRateMs);
     }
+    if (message.markerHeight !== 0) {
+      writer.uint32(21).float(message.markerHeight);
+    }
+    if (message.lostGracePeriodMs !== 0) {
+      writer.uint32(29).float(message.lostGracePeriodMs);
+    }
+    if (message.deltaUpdates !== false) {
+      writer.uint32(32).bool(message.deltaUpdates);
+    }
+    if (message.deltaTolerance !== 0) {
+      writer.uint32(45).float(message.deltaTolerance);
+    }
+    if (message.keyframeInterval !== 0) {
+      writer.uint32(48).int32(message.keyframeInterval);
+    }
+    if (message.mode !== 0) {
+      writer.uint32(56).int32(message.mode);
+    }
+    if (message.tableMargin !== 0) {
+      writer.uint32(69).float(message.tableMargin);
+    }
+    if (message.ledPairHeadings !== false) {
+      writer.uint32(72).bool(message.ledPairHeadings);
+    }
     return writer;
   },
 
@@ -1857,6 +2841,62 @@ export const TrackerStartTrackingRequest = {
 
           message.updateRateMs = reader.float();
           continue;
+        case 2:
+          if (tag !== 21) {
+            break;
+          }
+
+          message.markerHeight = reader.float();
+          continue;
+        case 3:
+          if (tag !== 29) {
+            break;
+          }
+
+          message.lostGracePeriodMs = reader.float();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.deltaUpdates = reader.bool();
+          continue;
+        case 5:
+          if (tag !== 45) {
+            break;
+          }
+
+          message.deltaTolerance = reader.float();
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.keyframeInterval = reader.int32();
+          continue;
+        case 7:
+          if (tag !== 56) {
+            break;
+          }
+
+          message.mode = reader.int32() as any;
+          continue;
+        case 8:
+          if (tag !== 69) {
+            break;
+          }
+
+          message.tableMargin = reader.float();
+          continue;
+        case 9:
+          if (tag !== 72) {
+            break;
+          }
+
+          message.ledPairHeadings = reader.bool();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1867,7 +2907,17 @@ export const TrackerStartTrackingRequest = {
   },
 
   fromJSON(object: any): TrackerStartTrackingRequest {
-    return { updateRateMs: isSet(object.updateRateMs) ? globalThis.Number(object.updateRateMs) : 0 };
+    return {
+      updateRateMs: isSet(object.updateRateMs) ? globalThis.Number(object.updateRateMs) : 0,
+      markerHeight: isSet(object.markerHeight) ? globalThis.Number(object.markerHeight) : 0,
+      lostGracePeriodMs: isSet(object.lostGracePeriodMs) ? globalThis.Number(object.lostGracePeriodMs) : 0,
+      deltaUpdates: isSet(object.deltaUpdates) ? globalThis.Boolean(object.deltaUpdates) : false,
+      deltaTolerance: isSet(object.deltaTolerance) ? globalThis.Number(object.deltaTolerance) : 0,
+      keyframeInterval: isSet(object.keyframeInterval) ? globalThis.Number(object.keyframeInterval) : 0,
+      mode: isSet(object.mode) ? trackerStartTrackingRequest_TrackingModeFromJSON(object.mode) : 0,
+      tableMargin: isSet(object.tableMargin) ? globalThis.Number(object.tableMargin) : 0,
+      ledPairHeadings: isSet(object.ledPairHeadings) ? globalThis.Boolean(object.ledPairHeadings) : false,
+    };
   },
 
   toJSON(message: TrackerStartTrackingRequest): unknown {
@@ -1875,6 +2925,30 @@ export const TrackerStartTrackingRequest = {
     if (message.updateRateMs !== 0) {
       obj.updateRateMs = message.updateRateMs;
     }
+    if (message.markerHeight !== 0) {
+      obj.markerHeight = message.markerHeight;
+    }
+    if (message.lostGracePeriodMs !== 0) {
+      obj.lostGracePeriodMs = message.lostGracePeriodMs;
+    }
+    if (message.deltaUpdates !== false) {
+      obj.deltaUpdates = message.deltaUpdates;
+    }
+    if (message.deltaTolerance !== 0) {
+      obj.deltaTolerance = message.deltaTolerance;
+    }
+    if (message.keyframeInterval !== 0) {
+      obj.keyframeInterval = Math.round(message.keyframeInterval);
+    }
+    if (message.mode !== 0) {
+      obj.mode = trackerStartTrackingRequest_TrackingModeToJSON(message.mode);
+    }
+    if (message.tableMargin !== 0) {
+      obj.tableMargin = message.tableMargin;
+    }
+    if (message.ledPairHeadings !== false) {
+      obj.ledPairHeadings = message.ledPairHeadings;
+    }
     return obj;
   },
 
@@ -1884,6 +2958,14 @@ export const TrackerStartTrackingRequest = {
   fromPartial<I extends Exact<DeepPartial<TrackerStartTrackingRequest>, I>>(object: I): TrackerStartTrackingRequest {
     const message = createBaseTrackerStartTrackingRequest();
     message.updateRateMs = object.updateRateMs ?? 0;
+    message.markerHeight = object.markerHeight ?? 0;
+    message.lostGracePeriodMs = object.lostGracePeriodMs ?? 0;
+    message.deltaUpdates = object.deltaUpdates ?? false;
+    message.deltaTolerance = object.deltaTolerance ?? 0;
+    message.keyframeInterval = object.keyframeInterval ?? 0;
+    message.mode = object.mode ?? 0;
+    message.tableMargin = object.tableMargin ?? 0;
+    message.ledPairHeadings = object.ledPairHeadings ?? false;
     return message;
   },
 };
@@ -1932,7 +3014,7 @@ export const TrackerGetMarkerLocationRequest = {
 };
 
 function createBaseTrackerGetMarkerLocationResponse(): TrackerGetMarkerLocationResponse {
-  return { markerLocations: {} };
+  return { markerLocations: {}, markerColors: {}, markers: {} };
 }
 
 export const TrackerGetMarkerLocationResponse = {
@@ -1941,6 +3023,14 @@ export const TrackerGetMarkerLocationResponse = {
       TrackerGetMarkerLocationResponse_MarkerLocationsEntry.encode({ key: key as any, value }, writer.uint32(10).fork())
         .ldelim();
     });
+    Object.entries(message.markerColors).forEach(([key, value]) => {
+      TrackerGetMarkerLocationResponse_MarkerColorsEntry.encode({ key: key as any, value }, writer.uint32(18).fork())
+        .ldelim();
+    });
+    Object.entries(message.markers).forEach(([key, value]) => {
+      TrackerGetMarkerLocationResponse_MarkersEntry.encode({ key: key as any, value }, writer.uint32(26).fork())
+        .ldelim();
+    });
     return writer;
   },
 
@@ -1961,6 +3051,26 @@ export const TrackerGetMarkerLocationResponse = {
             message.markerLocations[entry1.key] = entry1.value;
           }
           continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          const entry2 = TrackerGetMarkerLocationResponse_MarkerColorsEntry.decode(reader, reader.uint32());
+          if (entry2.value !== undefined) {
+            message.markerColors[entry2.key] = entry2.value;
+          }
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          const entry3 = TrackerGetMarkerLocationResponse_MarkersEntry.decode(reader, reader.uint32());
+          if (entry3.value !== undefined) {
+            message.markers[entry3.key] = entry3.value;
+          }
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1978,6 +3088,18 @@ export const TrackerGetMarkerLocationResponse = {
           return acc;
         }, {})
         : {},
+      markerColors: isObject(object.markerColors)
+        ? Object.entries(object.markerColors).reduce<{ [key: number]: TrackerMarkerColor }>((acc, [key, value]) => {
+          acc[globalThis.Number(key)] = trackerMarkerColorFromJSON(value);
+          return acc;
+        }, {})
+        : {},
+      markers: isObject(object.markers)
+        ? Object.entries(object.markers).reduce<{ [key: number]: TrackerMarker }>((acc, [key, value]) => {
+          acc[globalThis.Number(key)] = TrackerMarker.fromJSON(value);
+          return acc;
+        }, {})
+        : {},
     };
   },
 
@@ -1992,6 +3114,24 @@ export const TrackerGetMarkerLocationResponse = {
         });
       }
     }
+    if (message.markerColors) {
+      const entries = Object.entries(message.markerColors);
+      if (entries.length > 0) {
+        obj.markerColors = {};
+        entries.forEach(([k, v]) => {
+          obj.markerColors[k] = trackerMarkerColorToJSON(v);
+        });
+      }
+    }
+    if (message.markers) {
+      const entries = Object.entries(message.markers);
+      if (entries.length > 0) {
+        obj.markers = {};
+        entries.forEach(([k, v]) => {
+          obj.markers[k] = TrackerMarker.toJSON(v);
+        });
+      }
+    }
     return obj;
   },
 
@@ -2013,6 +3153,24 @@ export const TrackerGetMarkerLocationResponse = {
       },
       {},
     );
+    message.markerColors = Object.entries(object.markerColors ?? {}).reduce<{ [key: number]: TrackerMarkerColor }>(
+      (acc, [key, value]) => {
+        if (value !== undefined) {
+          acc[globalThis.Number(key)] = value as TrackerMarkerColor;
+        }
+        return acc;
+      },
+      {},
+    );
+    message.markers = Object.entries(object.markers ?? {}).reduce<{ [key: number]: TrackerMarker }>(
+      (acc, [key, value]) => {
+        if (value !== undefined) {
+          acc[globalThis.Number(key)] = TrackerMarker.fromPartial(value);
+        }
+        return acc;
+      },
+      {},
+    );
     return message;
   },
 };
@@ -2100,37 +3258,44 @@ export const TrackerGetMarkerLocationResponse_MarkerLocationsEntry = {
   },
 };
 
-function createBaseTrackerUpdateMarkerLocationRequest(): TrackerUpdateMarkerLocationRequest {
-  return { markerLocations: {} };
+function createBaseTrackerGetMarkerLocationResponse_MarkerColorsEntry(): TrackerGetMarkerLocationResponse_MarkerColorsEntry {
+  return { key: 0, value: 0 };
 }
 
-export const TrackerUpdateMarkerLocationRequest = {
-  encode(message: TrackerUpdateMarkerLocationRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    Object.entries(message.markerLocations).forEach(([key, value]) => {
-      TrackerUpdateMarkerLocationRequest_MarkerLocationsEntry.encode(
-        { key: key as any, value },
-        writer.uint32(10).fork(),
-      ).ldelim();
-    });
+export const TrackerGetMarkerLocationResponse_MarkerColorsEntry = {
+  encode(
+    message: TrackerGetMarkerLocationResponse_MarkerColorsEntry,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.key !== 0) {
+      writer.uint32(8).int32(message.key);
+    }
+    if (message.value !== 0) {
+      writer.uint32(16).int32(message.value);
+    }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): TrackerUpdateMarkerLocationRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): TrackerGetMarkerLocationResponse_MarkerColorsEntry {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseTrackerUpdateMarkerLocationRequest();
+    const message = createBaseTrackerGetMarkerLocationResponse_MarkerColorsEntry();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 10) {
+          if (tag !== 8) {
             break;
           }
 
-          const entry1 = TrackerUpdateMarkerLocationRequest_MarkerLocationsEntry.decode(reader, reader.uint32());
-          if (entry1.value !== undefined) {
-            message.markerLocations[entry1.key] = entry1.value;
+          message.key = reader.int32();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
           }
+
+          message.value = reader.int32() as any;
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -2141,27 +3306,295 @@ export const TrackerUpdateMarkerLocationRequest = {
     return message;
   },
 
-  fromJSON(object: any): TrackerUpdateMarkerLocationRequest {
+  fromJSON(object: any): TrackerGetMarkerLocationResponse_MarkerColorsEntry {
     return {
-      markerLocations: isObject(object.markerLocations)
-        ? Object.entries(object.markerLocations).reduce<{ [key: number]: TrackerVector2d }>((acc, [key, value]) => {
-          acc[globalThis.Number(key)] = TrackerVector2d.fromJSON(value);
-          return acc;
-        }, {})
-        : {},
+      key: isSet(object.key) ? globalThis.Number(object.key) : 0,
+      value: isSet(object.value) ? trackerMarkerColorFromJSON(object.value) : 0,
     };
   },
 
-  toJSON(message: TrackerUpdateMarkerLocationRequest): unknown {
+  toJSON(message: TrackerGetMarkerLocationResponse_MarkerColorsEntry): unknown {
     const obj: any = {};
-    if (message.markerLocations) {
-      const entries = Object.entries(message.markerLocations);
-      if (entries.length > 0) {
-        obj.markerLocations = {};
-        entries.forEach(([k, v]) => {
-          obj.markerLocations[k] = TrackerVector2d.toJSON(v);
-        });
-      }
+    if (message.key !== 0) {
+      obj.key = Math.round(message.key);
+    }
+    if (message.value !== 0) {
+      obj.value = trackerMarkerColorToJSON(message.value);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<TrackerGetMarkerLocationResponse_MarkerColorsEntry>, I>>(
+    base?: I,
+  ): TrackerGetMarkerLocationResponse_MarkerColorsEntry {
+    return TrackerGetMarkerLocationResponse_MarkerColorsEntry.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<TrackerGetMarkerLocationResponse_MarkerColorsEntry>, I>>(
+    object: I,
+  ): TrackerGetMarkerLocationResponse_MarkerColorsEntry {
+    const message = createBaseTrackerGetMarkerLocationResponse_MarkerColorsEntry();
+    message.key = object.key ?? 0;
+    message.value = object.value ?? 0;
+    return message;
+  },
+};
+
+function createBaseTrackerGetMarkerLocationResponse_MarkersEntry(): TrackerGetMarkerLocationResponse_MarkersEntry {
+  return { key: 0, value: undefined };
+}
+
+export const TrackerGetMarkerLocationResponse_MarkersEntry = {
+  encode(message: TrackerGetMarkerLocationResponse_MarkersEntry, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.key !== 0) {
+      writer.uint32(8).int32(message.key);
+    }
+    if (message.value !== undefined) {
+      TrackerMarker.encode(message.value, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): TrackerGetMarkerLocationResponse_MarkersEntry {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTrackerGetMarkerLocationResponse_MarkersEntry();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.key = reader.int32();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.value = TrackerMarker.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TrackerGetMarkerLocationResponse_MarkersEntry {
+    return {
+      key: isSet(object.key) ? globalThis.Number(object.key) : 0,
+      value: isSet(object.value) ? TrackerMarker.fromJSON(object.value) : undefined,
+    };
+  },
+
+  toJSON(message: TrackerGetMarkerLocationResponse_MarkersEntry): unknown {
+    const obj: any = {};
+    if (message.key !== 0) {
+      obj.key = Math.round(message.key);
+    }
+    if (message.value !== undefined) {
+      obj.value = TrackerMarker.toJSON(message.value);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<TrackerGetMarkerLocationResponse_MarkersEntry>, I>>(
+    base?: I,
+  ): TrackerGetMarkerLocationResponse_MarkersEntry {
+    return TrackerGetMarkerLocationResponse_MarkersEntry.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<TrackerGetMarkerLocationResponse_MarkersEntry>, I>>(
+    object: I,
+  ): TrackerGetMarkerLocationResponse_MarkersEntry {
+    const message = createBaseTrackerGetMarkerLocationResponse_MarkersEntry();
+    message.key = object.key ?? 0;
+    message.value = (object.value !== undefined && object.value !== null)
+      ? TrackerMarker.fromPartial(object.value)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseTrackerUpdateMarkerLocationRequest(): TrackerUpdateMarkerLocationRequest {
+  return { markerLocations: {}, markerColors: {}, markers: {}, sequence: 0, keyframe: false, removedKeys: [] };
+}
+
+export const TrackerUpdateMarkerLocationRequest = {
+  encode(message: TrackerUpdateMarkerLocationRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    Object.entries(message.markerLocations).forEach(([key, value]) => {
+      TrackerUpdateMarkerLocationRequest_MarkerLocationsEntry.encode(
+        { key: key as any, value },
+        writer.uint32(10).fork(),
+      ).ldelim();
+    });
+    Object.entries(message.markerColors).forEach(([key, value]) => {
+      TrackerUpdateMarkerLocationRequest_MarkerColorsEntry.encode({ key: key as any, value }, writer.uint32(18).fork())
+        .ldelim();
+    });
+    Object.entries(message.markers).forEach(([key, value]) => {
+      TrackerUpdateMarkerLocationRequest_MarkersEntry.encode({ key: key as any, value }, writer.uint32(26).fork())
+        .ldelim();
+    });
+    if (message.sequence !== 0) {
+      writer.uint32(32).uint32(message.sequence);
+    }
+    if (message.keyframe !== false) {
+      writer.uint32(40).bool(message.keyframe);
+    }
+    writer.uint32(50).fork();
+    for (const v of message.removedKeys) {
+      writer.int32(v);
+    }
+    writer.ldelim();
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): TrackerUpdateMarkerLocationRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTrackerUpdateMarkerLocationRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          const entry1 = TrackerUpdateMarkerLocationRequest_MarkerLocationsEntry.decode(reader, reader.uint32());
+          if (entry1.value !== undefined) {
+            message.markerLocations[entry1.key] = entry1.value;
+          }
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          const entry2 = TrackerUpdateMarkerLocationRequest_MarkerColorsEntry.decode(reader, reader.uint32());
+          if (entry2.value !== undefined) {
+            message.markerColors[entry2.key] = entry2.value;
+          }
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          const entry3 = TrackerUpdateMarkerLocationRequest_MarkersEntry.decode(reader, reader.uint32());
+          if (entry3.value !== undefined) {
+            message.markers[entry3.key] = entry3.value;
+          }
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.sequence = reader.uint32();
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.keyframe = reader.bool();
+          continue;
+        case 6:
+          if (tag === 48) {
+            message.removedKeys.push(reader.int32());
+
+            continue;
+          }
+
+          if (tag === 50) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.removedKeys.push(reader.int32());
+            }
+
+            continue;
+          }
+
+          break;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TrackerUpdateMarkerLocationRequest {
+    return {
+      markerLocations: isObject(object.markerLocations)
+        ? Object.entries(object.markerLocations).reduce<{ [key: number]: TrackerVector2d }>((acc, [key, value]) => {
+          acc[globalThis.Number(key)] = TrackerVector2d.fromJSON(value);
+          return acc;
+        }, {})
+        : {},
+      markerColors: isObject(object.markerColors)
+        ? Object.entries(object.markerColors).reduce<{ [key: number]: TrackerMarkerColor }>((acc, [key, value]) => {
+          acc[globalThis.Number(key)] = trackerMarkerColorFromJSON(value);
+          return acc;
+        }, {})
+        : {},
+      markers: isObject(object.markers)
+        ? Object.entries(object.markers).reduce<{ [key: number]: TrackerMarker }>((acc, [key, value]) => {
+          acc[globalThis.Number(key)] = TrackerMarker.fromJSON(value);
+          return acc;
+        }, {})
+        : {},
+      sequence: isSet(object.sequence) ? globalThis.Number(object.sequence) : 0,
+      keyframe: isSet(object.keyframe) ? globalThis.Boolean(object.keyframe) : false,
+      removedKeys: globalThis.Array.isArray(object?.removedKeys)
+        ? object.removedKeys.map((e: any) => globalThis.Number(e))
+        : [],
+    };
+  },
+
+  toJSON(message: TrackerUpdateMarkerLocationRequest): unknown {
+    const obj: any = {};
+    if (message.markerLocations) {
+      const entries = Object.entries(message.markerLocations);
+      if (entries.length > 0) {
+        obj.markerLocations = {};
+        entries.forEach(([k, v]) => {
+          obj.markerLocations[k] = TrackerVector2d.toJSON(v);
+        });
+      }
+    }
+    if (message.markerColors) {
+      const entries = Object.entries(message.markerColors);
+      if (entries.length > 0) {
+        obj.markerColors = {};
+        entries.forEach(([k, v]) => {
+          obj.markerColors[k] = trackerMarkerColorToJSON(v);
+        });
+      }
+    }
+    if (message.markers) {
+      const entries = Object.entries(message.markers);
+      if (entries.length > 0) {
+        obj.markers = {};
+        entries.forEach(([k, v]) => {
+          obj.markers[k] = TrackerMarker.toJSON(v);
+        });
+      }
+    }
+    if (message.sequence !== 0) {
+      obj.sequence = Math.round(message.sequence);
+    }
+    if (message.keyframe !== false) {
+      obj.keyframe = message.keyframe;
+    }
+    if (message.removedKeys?.length) {
+      obj.removedKeys = message.removedKeys.map((e) => Math.round(e));
     }
     return obj;
   },
@@ -2184,6 +3617,27 @@ export const TrackerUpdateMarkerLocationRequest = {
       },
       {},
     );
+    message.markerColors = Object.entries(object.markerColors ?? {}).reduce<{ [key: number]: TrackerMarkerColor }>(
+      (acc, [key, value]) => {
+        if (value !== undefined) {
+          acc[globalThis.Number(key)] = value as TrackerMarkerColor;
+        }
+        return acc;
+      },
+      {},
+    );
+    message.markers = Object.entries(object.markers ?? {}).reduce<{ [key: number]: TrackerMarker }>(
+      (acc, [key, value]) => {
+        if (value !== undefined) {
+          acc[globalThis.Number(key)] = TrackerMarker.fromPartial(value);
+        }
+        return acc;
+      },
+      {},
+    );
+    message.sequence = object.sequence ?? 0;
+    message.keyframe = object.keyframe ?? false;
+    message.removedKeys = object.removedKeys?.map((e) => e) || [];
     return message;
   },
 };
@@ -2271,6 +3725,1208 @@ export const TrackerUpdateMarkerLocationRequest_MarkerLocationsEntry = {
   },
 };
 
+function createBaseTrackerUpdateMarkerLocationRequest_MarkerColorsEntry(): TrackerUpdateMarkerLocationRequest_MarkerColorsEntry {
+  return { key: 0, value: 0 };
+}
+
+export const TrackerUpdateMarkerLocationRequest_MarkerColorsEntry = {
+  encode(
+    message: TrackerUpdateMarkerLocationRequest_MarkerColorsEntry,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.key !== 0) {
+      writer.uint32(8).int32(message.key);
+    }
+    if (message.value !== 0) {
+      writer.uint32(16).int32(message.value);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): TrackerUpdateMarkerLocationRequest_MarkerColorsEntry {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTrackerUpdateMarkerLocationRequest_MarkerColorsEntry();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.key = reader.int32();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.value = reader.int32() as any;
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TrackerUpdateMarkerLocationRequest_MarkerColorsEntry {
+    return {
+      key: isSet(object.key) ? globalThis.Number(object.key) : 0,
+      value: isSet(object.value) ? trackerMarkerColorFromJSON(object.value) : 0,
+    };
+  },
+
+  toJSON(message: TrackerUpdateMarkerLocationRequest_MarkerColorsEntry): unknown {
+    const obj: any = {};
+    if (message.key !== 0) {
+      obj.key = Math.round(message.key);
+    }
+    if (message.value !== 0) {
+      obj.value = trackerMarkerColorToJSON(message.value);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<TrackerUpdateMarkerLocationRequest_MarkerColorsEntry>, I>>(
+    base?: I,
+  ): TrackerUpdateMarkerLocationRequest_MarkerColorsEntry {
+    return TrackerUpdateMarkerLocationRequest_MarkerColorsEntry.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<TrackerUpdateMarkerLocationRequest_MarkerColorsEntry>, I>>(
+    object: I,
+  ): TrackerUpdateMarkerLocationRequest_MarkerColorsEntry {
+    const message = createBaseTrackerUpdateMarkerLocationRequest_MarkerColorsEntry();
+    message.key = object.key ?? 0;
+    message.value = object.value ?? 0;
+    return message;
+  },
+};
+
+function createBaseTrackerUpdateMarkerLocationRequest_MarkersEntry(): TrackerUpdateMarkerLocationRequest_MarkersEntry {
+  return { key: 0, value: undefined };
+}
+
+export const TrackerUpdateMarkerLocationRequest_MarkersEntry = {
+  encode(
+    message: TrackerUpdateMarkerLocationRequest_MarkersEntry,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.key !== 0) {
+      writer.uint32(8).int32(message.key);
+    }
+    if (message.value !== undefined) {
+      TrackerMarker.encode(message.value, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): TrackerUpdateMarkerLocationRequest_MarkersEntry {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTrackerUpdateMarkerLocationRequest_MarkersEntry();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.key = reader.int32();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.value = TrackerMarker.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TrackerUpdateMarkerLocationRequest_MarkersEntry {
+    return {
+      key: isSet(object.key) ? globalThis.Number(object.key) : 0,
+      value: isSet(object.value) ? TrackerMarker.fromJSON(object.value) : undefined,
+    };
+  },
+
+  toJSON(message: TrackerUpdateMarkerLocationRequest_MarkersEntry): unknown {
+    const obj: any = {};
+    if (message.key !== 0) {
+      obj.key = Math.round(message.key);
+    }
+    if (message.value !== undefined) {
+      obj.value = TrackerMarker.toJSON(message.value);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<TrackerUpdateMarkerLocationRequest_MarkersEntry>, I>>(
+    base?: I,
+  ): TrackerUpdateMarkerLocationRequest_MarkersEntry {
+    return TrackerUpdateMarkerLocationRequest_MarkersEntry.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<TrackerUpdateMarkerLocationRequest_MarkersEntry>, I>>(
+    object: I,
+  ): TrackerUpdateMarkerLocationRequest_MarkersEntry {
+    const message = createBaseTrackerUpdateMarkerLocationRequest_MarkersEntry();
+    message.key = object.key ?? 0;
+    message.value = (object.value !== undefined && object.value !== null)
+      ? TrackerMarker.fromPartial(object.value)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseTrackerResyncMarkersRequest(): TrackerResyncMarkersRequest {
+  return {};
+}
+
+export const TrackerResyncMarkersRequest = {
+  encode(_: TrackerResyncMarkersRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): TrackerResyncMarkersRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTrackerResyncMarkersRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(_: any): TrackerResyncMarkersRequest {
+    return {};
+  },
+
+  toJSON(_: TrackerResyncMarkersRequest): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<TrackerResyncMarkersRequest>, I>>(base?: I): TrackerResyncMarkersRequest {
+    return TrackerResyncMarkersRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<TrackerResyncMarkersRequest>, I>>(_: I): TrackerResyncMarkersRequest {
+    const message = createBaseTrackerResyncMarkersRequest();
+    return message;
+  },
+};
+
+function createBaseTrackerSetDisplayMaskRequest(): TrackerSetDisplayMaskRequest {
+  return { displayImage: new Uint8Array(0), displayOrigin: undefined, displaySize: undefined, exclusionPolygons: [] };
+}
+
+export const TrackerSetDisplayMaskRequest = {
+  encode(message: TrackerSetDisplayMaskRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.displayImage.length !== 0) {
+      writer.uint32(10).bytes(message.displayImage);
+    }
+    if (message.displayOrigin !== undefined) {
+      TrackerVector2d.encode(message.displayOrigin, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.displaySize !== undefined) {
+      TrackerVector2d.encode(message.displaySize, writer.uint32(26).fork()).ldelim();
+    }
+    for (const v of message.exclusionPolygons) {
+      TrackerPolygon.encode(v!, writer.uint32(34).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): TrackerSetDisplayMaskRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTrackerSetDisplayMaskRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.displayImage = reader.bytes();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.displayOrigin = TrackerVector2d.decode(reader, reader.uint32());
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.displaySize = TrackerVector2d.decode(reader, reader.uint32());
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.exclusionPolygons.push(TrackerPolygon.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TrackerSetDisplayMaskRequest {
+    return {
+      displayImage: isSet(object.displayImage) ? bytesFromBase64(object.displayImage) : new Uint8Array(0),
+      displayOrigin: isSet(object.displayOrigin) ? TrackerVector2d.fromJSON(object.displayOrigin) : undefined,
+      displaySize: isSet(object.displaySize) ? TrackerVector2d.fromJSON(object.displaySize) : undefined,
+      exclusionPolygons: globalThis.Array.isArray(object?.exclusionPolygons)
+        ? object.exclusionPolygons.map((e: any) => TrackerPolygon.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: TrackerSetDisplayMaskRequest): unknown {
+    const obj: any = {};
+    if (message.displayImage.length !== 0) {
+      obj.displayImage = base64FromBytes(message.displayImage);
+    }
+    if (message.displayOrigin !== undefined) {
+      obj.displayOrigin = TrackerVector2d.toJSON(message.displayOrigin);
+    }
+    if (message.displaySize !== undefined) {
+      obj.displaySize = TrackerVector2d.toJSON(message.displaySize);
+    }
+    if (message.exclusionPolygons?.length) {
+      obj.exclusionPolygons = message.exclusionPolygons.map((e) => TrackerPolygon.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<TrackerSetDisplayMaskRequest>, I>>(base?: I): TrackerSetDisplayMaskRequest {
+    return TrackerSetDisplayMaskRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<TrackerSetDisplayMaskRequest>, I>>(object: I): TrackerSetDisplayMaskRequest {
+    const message = createBaseTrackerSetDisplayMaskRequest();
+    message.displayImage = object.displayImage ?? new Uint8Array(0);
+    message.displayOrigin = (object.displayOrigin !== undefined && object.displayOrigin !== null)
+      ? TrackerVector2d.fromPartial(object.displayOrigin)
+      : undefined;
+    message.displaySize = (object.displaySize !== undefined && object.displaySize !== null)
+      ? TrackerVector2d.fromPartial(object.displaySize)
+      : undefined;
+    message.exclusionPolygons = object.exclusionPolygons?.map((e) => TrackerPolygon.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseTrackerSetDetectionParametersRequest(): TrackerSetDetectionParametersRequest {
+  return {
+    minBrightness: 0,
+    adaptiveOffset: 0,
+    adaptiveBlockSize: 0,
+    backgroundLearningRate: 0,
+    foregroundThreshold: 0,
+    disableBackgroundModel: false,
+    resetBackground: false,
+  };
+}
+
+export const TrackerSetDetectionParametersRequest = {
+  encode(message: TrackerSetDetectionParametersRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.minBrightness !== 0) {
+      writer.uint32(13).float(message.minBrightness);
+    }
+    if (message.adaptiveOffset !== 0) {
+      writer.uint32(21).float(message.adaptiveOffset);
+    }
+    if (message.adaptiveBlockSize !== 0) {
+      writer.uint32(24).int32(message.adaptiveBlockSize);
+    }
+    if (message.backgroundLearningRate !== 0) {
+      writer.uint32(37).float(message.backgroundLearningRate);
+    }
+    if (message.foregroundThreshold !== 0) {
+      writer.uint32(45).float(message.foregroundThreshold);
+    }
+    if (message.disableBackgroundModel !== false) {
+      writer.uint32(48).bool(message.disableBackgroundModel);
+    }
+    if (message.resetBackground !== false) {
+      writer.uint32(56).bool(message.resetBackground);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): TrackerSetDetectionParametersRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTrackerSetDetectionParametersRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 13) {
+            break;
+          }
+
+          message.minBrightness = reader.float();
+          continue;
+        case 2:
+          if (tag !== 21) {
+            break;
+          }
+
+          message.adaptiveOffset = reader.float();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.adaptiveBlockSize = reader.int32();
+          continue;
+        case 4:
+          if (tag !== 37) {
+            break;
+          }
+
+          message.backgroundLearningRate = reader.float();
+          continue;
+        case 5:
+          if (tag !== 45) {
+            break;
+          }
+
+          message.foregroundThreshold = reader.float();
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.disableBackgroundModel = reader.bool();
+          continue;
+        case 7:
+          if (tag !== 56) {
+            break;
+          }
+
+          message.resetBackground = reader.bool();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TrackerSetDetectionParametersRequest {
+    return {
+      minBrightness: isSet(object.minBrightness) ? globalThis.Number(object.minBrightness) : 0,
+      adaptiveOffset: isSet(object.adaptiveOffset) ? globalThis.Number(object.adaptiveOffset) : 0,
+      adaptiveBlockSize: isSet(object.adaptiveBlockSize) ? globalThis.Number(object.adaptiveBlockSize) : 0,
+      backgroundLearningRate: isSet(object.backgroundLearningRate)
+        ? globalThis.Number(object.backgroundLearningRate)
+        : 0,
+      foregroundThreshold: isSet(object.foregroundThreshold) ? globalThis.Number(object.foregroundThreshold) : 0,
+      disableBackgroundModel: isSet(object.disableBackgroundModel)
+        ? globalThis.Boolean(object.disableBackgroundModel)
+        : false,
+      resetBackground: isSet(object.resetBackground) ? globalThis.Boolean(object.resetBackground) : false,
+    };
+  },
+
+  toJSON(message: TrackerSetDetectionParametersRequest): unknown {
+    const obj: any = {};
+    if (message.minBrightness !== 0) {
+      obj.minBrightness = message.minBrightness;
+    }
+    if (message.adaptiveOffset !== 0) {
+      obj.adaptiveOffset = message.adaptiveOffset;
+    }
+    if (message.adaptiveBlockSize !== 0) {
+      obj.adaptiveBlockSize = Math.round(message.adaptiveBlockSize);
+    }
+    if (message.backgroundLearningRate !== 0) {
+      obj.backgroundLearningRate = message.backgroundLearningRate;
+    }
+    if (message.foregroundThreshold !== 0) {
+      obj.foregroundThreshold = message.foregroundThreshold;
+    }
+    if (message.disableBackgroundModel !== false) {
+      obj.disableBackgroundModel = message.disableBackgroundModel;
+    }
+    if (message.resetBackground !== false) {
+      obj.resetBackground = message.resetBackground;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<TrackerSetDetectionParametersRequest>, I>>(
+    base?: I,
+  ): TrackerSetDetectionParametersRequest {
+    return TrackerSetDetectionParametersRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<TrackerSetDetectionParametersRequest>, I>>(
+    object: I,
+  ): TrackerSetDetectionParametersRequest {
+    const message = createBaseTrackerSetDetectionParametersRequest();
+    message.minBrightness = object.minBrightness ?? 0;
+    message.adaptiveOffset = object.adaptiveOffset ?? 0;
+    message.adaptiveBlockSize = object.adaptiveBlockSize ?? 0;
+    message.backgroundLearningRate = object.backgroundLearningRate ?? 0;
+    message.foregroundThreshold = object.foregroundThreshold ?? 0;
+    message.disableBackgroundModel = object.disableBackgroundModel ?? false;
+    message.resetBackground = object.resetBackground ?? false;
+    return message;
+  },
+};
+
+function createBaseTrackerPolygon(): TrackerPolygon {
+  return { vertices: [] };
+}
+
+export const TrackerPolygon = {
+  encode(message: TrackerPolygon, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.vertices) {
+      TrackerVector2d.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): TrackerPolygon {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTrackerPolygon();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.vertices.push(TrackerVector2d.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TrackerPolygon {
+    return {
+      vertices: globalThis.Array.isArray(object?.vertices)
+        ? object.vertices.map((e: any) => TrackerVector2d.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: TrackerPolygon): unknown {
+    const obj: any = {};
+    if (message.vertices?.length) {
+      obj.vertices = message.vertices.map((e) => TrackerVector2d.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<TrackerPolygon>, I>>(base?: I): TrackerPolygon {
+    return TrackerPolygon.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<TrackerPolygon>, I>>(object: I): TrackerPolygon {
+    const message = createBaseTrackerPolygon();
+    message.vertices = object.vertices?.map((e) => TrackerVector2d.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseTrackerMarker(): TrackerMarker {
+  return {
+    location: undefined,
+    velocity: undefined,
+    pixel: undefined,
+    color: 0,
+    area: 0,
+    circularity: 0,
+    brightness: 0,
+    lost: false,
+    heading: 0,
+    hasHeading: false,
+    headingConfidence: 0,
+    headingSource: 0,
+    pairedKey: 0,
+  };
+}
+
+export const TrackerMarker = {
+  encode(message: TrackerMarker, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.location !== undefined) {
+      TrackerVector2d.encode(message.location, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.velocity !== undefined) {
+      TrackerVector2d.encode(message.velocity, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.pixel !== undefined) {
+      TrackerVector2d.encode(message.pixel, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.color !== 0) {
+      writer.uint32(32).int32(message.color);
+    }
+    if (message.area !== 0) {
+      writer.uint32(45).float(message.area);
+    }
+    if (message.circularity !== 0) {
+      writer.uint32(53).float(message.circularity);
+    }
+    if (message.brightness !== 0) {
+      writer.uint32(61).float(message.brightness);
+    }
+    if (message.lost !== false) {
+      writer.uint32(64).bool(message.lost);
+    }
+    if (message.heading !== 0) {
+      writer.uint32(77).float(message.heading);
+    }
+    if (message.hasHeading !== false) {
+      writer.uint32(80).bool(message.hasHeading);
+    }
+    if (message.headingConfidence !== 0) {
+      writer.uint32(93).float(message.headingConfidence);
+    }
+    if (message.headingSource !== 0) {
+      writer.uint32(96).int32(message.headingSource);
+    }
+    if (message.pairedKey !== 0) {
+      writer.uint32(104).int32(message.pairedKey);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): TrackerMarker {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTrackerMarker();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.location = TrackerVector2d.decode(reader, reader.uint32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.velocity = TrackerVector2d.decode(reader, reader.uint32());
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.pixel = TrackerVector2d.decode(reader, reader.uint32());
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.color = reader.int32() as any;
+          continue;
+        case 5:
+          if (tag !== 45) {
+            break;
+          }
+
+          message.area = reader.float();
+          continue;
+        case 6:
+          if (tag !== 53) {
+            break;
+          }
+
+          message.circularity = reader.float();
+          continue;
+        case 7:
+          if (tag !== 61) {
+            break;
+          }
+
+          message.brightness = reader.float();
+          continue;
+        case 8:
+          if (tag !== 64) {
+            break;
+          }
+
+          message.lost = reader.bool();
+          continue;
+        case 9:
+          if (tag !== 77) {
+            break;
+          }
+
+          message.heading = reader.float();
+          continue;
+        case 10:
+          if (tag !== 80) {
+            break;
+          }
+
+          message.hasHeading = reader.bool();
+          continue;
+        case 11:
+          if (tag !== 93) {
+            break;
+          }
+
+          message.headingConfidence = reader.float();
+          continue;
+        case 12:
+          if (tag !== 96) {
+            break;
+          }
+
+          message.headingSource = reader.int32() as any;
+          continue;
+        case 13:
+          if (tag !== 104) {
+            break;
+          }
+
+          message.pairedKey = reader.int32();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TrackerMarker {
+    return {
+      location: isSet(object.location) ? TrackerVector2d.fromJSON(object.location) : undefined,
+      velocity: isSet(object.velocity) ? TrackerVector2d.fromJSON(object.velocity) : undefined,
+      pixel: isSet(object.pixel) ? TrackerVector2d.fromJSON(object.pixel) : undefined,
+      color: isSet(object.color) ? trackerMarkerColorFromJSON(object.color) : 0,
+      area: isSet(object.area) ? globalThis.Number(object.area) : 0,
+      circularity: isSet(object.circularity) ? globalThis.Number(object.circularity) : 0,
+      brightness: isSet(object.brightness) ? globalThis.Number(object.brightness) : 0,
+      lost: isSet(object.lost) ? globalThis.Boolean(object.lost) : false,
+      heading: isSet(object.heading) ? globalThis.Number(object.heading) : 0,
+      hasHeading: isSet(object.hasHeading) ? globalThis.Boolean(object.hasHeading) : false,
+      headingConfidence: isSet(object.headingConfidence) ? globalThis.Number(object.headingConfidence) : 0,
+      headingSource: isSet(object.headingSource) ? trackerMarker_HeadingSourceFromJSON(object.headingSource) : 0,
+      pairedKey: isSet(object.pairedKey) ? globalThis.Number(object.pairedKey) : 0,
+    };
+  },
+
+  toJSON(message: TrackerMarker): unknown {
+    const obj: any = {};
+    if (message.location !== undefined) {
+      obj.location = TrackerVector2d.toJSON(message.location);
+    }
+    if (message.velocity !== undefined) {
+      obj.velocity = TrackerVector2d.toJSON(message.velocity);
+    }
+    if (message.pixel !== undefined) {
+      obj.pixel = TrackerVector2d.toJSON(message.pixel);
+    }
+    if (message.color !== 0) {
+      obj.color = trackerMarkerColorToJSON(message.color);
+    }
+    if (message.area !== 0) {
+      obj.area = message.area;
+    }
+    if (message.circularity !== 0) {
+      obj.circularity = message.circularity;
+    }
+    if (message.brightness !== 0) {
+      obj.brightness = message.brightness;
+    }
+    if (message.lost !== false) {
+      obj.lost = message.lost;
+    }
+    if (message.heading !== 0) {
+      obj.heading = message.heading;
+    }
+    if (message.hasHeading !== false) {
+      obj.hasHeading = message.hasHeading;
+    }
+    if (message.headingConfidence !== 0) {
+      obj.headingConfidence = message.headingConfidence;
+    }
+    if (message.headingSource !== 0) {
+      obj.headingSource = trackerMarker_HeadingSourceToJSON(message.headingSource);
+    }
+    if (message.pairedKey !== 0) {
+      obj.pairedKey = Math.round(message.pairedKey);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<TrackerMarker>, I>>(base?: I): TrackerMarker {
+    return TrackerMarker.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<TrackerMarker>, I>>(object: I): TrackerMarker {
+    const message = createBaseTrackerMarker();
+    message.location = (object.location !== undefined && object.location !== null)
+      ? TrackerVector2d.fromPartial(object.location)
+      : undefined;
+    message.velocity = (object.velocity !== undefined && object.velocity !== null)
+      ? TrackerVector2d.fromPartial(object.velocity)
+      : undefined;
+    message.pixel = (object.pixel !== undefined && object.pixel !== null)
+      ? TrackerVector2d.fromPartial(object.pixel)
+      : undefined;
+    message.color = object.color ?? 0;
+    message.area = object.area ?? 0;
+    message.circularity = object.circularity ?? 0;
+    message.brightness = object.brightness ?? 0;
+    message.lost = object.lost ?? false;
+    message.heading = object.heading ?? 0;
+    message.hasHeading = object.hasHeading ?? false;
+    message.headingConfidence = object.headingConfidence ?? 0;
+    message.headingSource = object.headingSource ?? 0;
+    message.pairedKey = object.pairedKey ?? 0;
+    return message;
+  },
+};
+
+function createBaseTrackerMarkerEventsRequest(): TrackerMarkerEventsRequest {
+  return { events: [] };
+}
+
+export const TrackerMarkerEventsRequest = {
+  encode(message: TrackerMarkerEventsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.events) {
+      TrackerMarkerEvent.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): TrackerMarkerEventsRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTrackerMarkerEventsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.events.push(TrackerMarkerEvent.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TrackerMarkerEventsRequest {
+    return {
+      events: globalThis.Array.isArray(object?.events)
+        ? object.events.map((e: any) => TrackerMarkerEvent.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: TrackerMarkerEventsRequest): unknown {
+    const obj: any = {};
+    if (message.events?.length) {
+      obj.events = message.events.map((e) => TrackerMarkerEvent.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<TrackerMarkerEventsRequest>, I>>(base?: I): TrackerMarkerEventsRequest {
+    return TrackerMarkerEventsRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<TrackerMarkerEventsRequest>, I>>(object: I): TrackerMarkerEventsRequest {
+    const message = createBaseTrackerMarkerEventsRequest();
+    message.events = object.events?.map((e) => TrackerMarkerEvent.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseTrackerMarkerEvent(): TrackerMarkerEvent {
+  return { type: 0, key: 0, marker: undefined };
+}
+
+export const TrackerMarkerEvent = {
+  encode(message: TrackerMarkerEvent, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.type !== 0) {
+      writer.uint32(8).int32(message.type);
+    }
+    if (message.key !== 0) {
+      writer.uint32(16).int32(message.key);
+    }
+    if (message.marker !== undefined) {
+      TrackerMarker.encode(message.marker, writer.uint32(26).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): TrackerMarkerEvent {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTrackerMarkerEvent();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.type = reader.int32() as any;
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.key = reader.int32();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.marker = TrackerMarker.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TrackerMarkerEvent {
+    return {
+      type: isSet(object.type) ? trackerMarkerEvent_EventTypeFromJSON(object.type) : 0,
+      key: isSet(object.key) ? globalThis.Number(object.key) : 0,
+      marker: isSet(object.marker) ? TrackerMarker.fromJSON(object.marker) : undefined,
+    };
+  },
+
+  toJSON(message: TrackerMarkerEvent): unknown {
+    const obj: any = {};
+    if (message.type !== 0) {
+      obj.type = trackerMarkerEvent_EventTypeToJSON(message.type);
+    }
+    if (message.key !== 0) {
+      obj.key = Math.round(message.key);
+    }
+    if (message.marker !== undefined) {
+      obj.marker = TrackerMarker.toJSON(message.marker);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<TrackerMarkerEvent>, I>>(base?: I): TrackerMarkerEvent {
+    return TrackerMarkerEvent.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<TrackerMarkerEvent>, I>>(object: I): TrackerMarkerEvent {
+    const message = createBaseTrackerMarkerEvent();
+    message.type = object.type ?? 0;
+    message.key = object.key ?? 0;
+    message.marker = (object.marker !== undefined && object.marker !== null)
+      ? TrackerMarker.fromPartial(object.marker)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseTrackerStartIntrinsicCalibrationRequest(): TrackerStartIntrinsicCalibrationRequest {
+  return { columns: 0, rows: 0, squareSize: 0 };
+}
+
+export const TrackerStartIntrinsicCalibrationRequest = {
+  encode(message: TrackerStartIntrinsicCalibrationRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.columns !== 0) {
+      writer.uint32(8).int32(message.columns);
+    }
+    if (message.rows !== 0) {
+      writer.uint32(16).int32(message.rows);
+    }
+    if (message.squareSize !== 0) {
+      writer.uint32(29).float(message.squareSize);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): TrackerStartIntrinsicCalibrationRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTrackerStartIntrinsicCalibrationRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.columns = reader.int32();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.rows = reader.int32();
+          continue;
+        case 3:
+          if (tag !== 29) {
+            break;
+          }
+
+          message.squareSize = reader.float();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TrackerStartIntrinsicCalibrationRequest {
+    return {
+      columns: isSet(object.columns) ? globalThis.Number(object.columns) : 0,
+      rows: isSet(object.rows) ? globalThis.Number(object.rows) : 0,
+      squareSize: isSet(object.squareSize) ? globalThis.Number(object.squareSize) : 0,
+    };
+  },
+
+  toJSON(message: TrackerStartIntrinsicCalibrationRequest): unknown {
+    const obj: any = {};
+    if (message.columns !== 0) {
+      obj.columns = Math.round(message.columns);
+    }
+    if (message.rows !== 0) {
+      obj.rows = Math.round(message.rows);
+    }
+    if (message.squareSize !== 0) {
+      obj.squareSize = message.squareSize;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<TrackerStartIntrinsicCalibrationRequest>, I>>(
+    base?: I,
+  ): TrackerStartIntrinsicCalibrationRequest {
+    return TrackerStartIntrinsicCalibrationRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<TrackerStartIntrinsicCalibrationRequest>, I>>(
+    object: I,
+  ): TrackerStartIntrinsicCalibrationRequest {
+    const message = createBaseTrackerStartIntrinsicCalibrationRequest();
+    message.columns = object.columns ?? 0;
+    message.rows = object.rows ?? 0;
+    message.squareSize = object.squareSize ?? 0;
+    return message;
+  },
+};
+
+function createBaseTrackerGetIntrinsicCalibrationRequest(): TrackerGetIntrinsicCalibrationRequest {
+  return {};
+}
+
+export const TrackerGetIntrinsicCalibrationRequest = {
+  encode(_: TrackerGetIntrinsicCalibrationRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): TrackerGetIntrinsicCalibrationRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTrackerGetIntrinsicCalibrationRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(_: any): TrackerGetIntrinsicCalibrationRequest {
+    return {};
+  },
+
+  toJSON(_: TrackerGetIntrinsicCalibrationRequest): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<TrackerGetIntrinsicCalibrationRequest>, I>>(
+    base?: I,
+  ): TrackerGetIntrinsicCalibrationRequest {
+    return TrackerGetIntrinsicCalibrationRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<TrackerGetIntrinsicCalibrationRequest>, I>>(
+    _: I,
+  ): TrackerGetIntrinsicCalibrationRequest {
+    const message = createBaseTrackerGetIntrinsicCalibrationRequest();
+    return message;
+  },
+};
+
+function createBaseTrackerGetIntrinsicCalibrationResponse(): TrackerGetIntrinsicCalibrationResponse {
+  return { calibrated: false, reprojectionError: 0, viewsCaptured: 0, viewsRequired: 0 };
+}
+
+export const TrackerGetIntrinsicCalibrationResponse = {
+  encode(message: TrackerGetIntrinsicCalibrationResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.calibrated !== false) {
+      writer.uint32(8).bool(message.calibrated);
+    }
+    if (message.reprojectionError !== 0) {
+      writer.uint32(17).double(message.reprojectionError);
+    }
+    if (message.viewsCaptured !== 0) {
+      writer.uint32(24).int32(message.viewsCaptured);
+    }
+    if (message.viewsRequired !== 0) {
+      writer.uint32(32).int32(message.viewsRequired);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): TrackerGetIntrinsicCalibrationResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTrackerGetIntrinsicCalibrationResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.calibrated = reader.bool();
+          continue;
+        case 2:
+          if (tag !== 17) {
+            break;
+          }
+
+          message.reprojectionError = reader.double();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.viewsCaptured = reader.int32();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.viewsRequired = reader.int32();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TrackerGetIntrinsicCalibrationResponse {
+    return {
+      calibrated: isSet(object.calibrated) ? globalThis.Boolean(object.calibrated) : false,
+      reprojectionError: isSet(object.reprojectionError) ? globalThis.Number(object.reprojectionError) : 0,
+      viewsCaptured: isSet(object.viewsCaptured) ? globalThis.Number(object.viewsCaptured) : 0,
+      viewsRequired: isSet(object.viewsRequired) ? globalThis.Number(object.viewsRequired) : 0,
+    };
+  },
+
+  toJSON(message: TrackerGetIntrinsicCalibrationResponse): unknown {
+    const obj: any = {};
+    if (message.calibrated !== false) {
+      obj.calibrated = message.calibrated;
+    }
+    if (message.reprojectionError !== 0) {
+      obj.reprojectionError = message.reprojectionError;
+    }
+    if (message.viewsCaptured !== 0) {
+      obj.viewsCaptured = Math.round(message.viewsCaptured);
+    }
+    if (message.viewsRequired !== 0) {
+      obj.viewsRequired = Math.round(message.viewsRequired);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<TrackerGetIntrinsicCalibrationResponse>, I>>(
+    base?: I,
+  ): TrackerGetIntrinsicCalibrationResponse {
+    return TrackerGetIntrinsicCalibrationResponse.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<TrackerGetIntrinsicCalibrationResponse>, I>>(
+    object: I,
+  ): TrackerGetIntrinsicCalibrationResponse {
+    const message = createBaseTrackerGetIntrinsicCalibrationResponse();
+    message.calibrated = object.calibrated ?? false;
+    message.reprojectionError = object.reprojectionError ?? 0;
+    message.viewsCaptured = object.viewsCaptured ?? 0;
+    message.viewsRequired = object.viewsRequired ?? 0;
+    return message;
+  },
+};
+
 function bytesFromBase64(b64: string): Uint8Array {
   if ((globalThis as any).Buffer) {
     return Uint8Array.from(globalThis.Buffer.from(b64, "base64"));
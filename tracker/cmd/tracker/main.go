@@ -2,15 +2,40 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"fmt"
+	"image"
+	"io/fs"
+	"net/http"
 	_ "net/http/pprof"
 	"os"
 	"os/signal"
+	"strings"
 
 	"github.com/tutman96/fantassist.io/tracker/pkg"
+	"github.com/tutman96/fantassist.io/tracker/pkg/ble"
+	"github.com/tutman96/fantassist.io/tracker/pkg/calib3d"
+	"github.com/tutman96/fantassist.io/tracker/pkg/channel"
+	"github.com/tutman96/fantassist.io/tracker/pkg/libcamera"
+	"github.com/tutman96/fantassist.io/tracker/pkg/replay"
+	"github.com/tutman96/fantassist.io/tracker/pkg/synthetic"
+	"github.com/tutman96/fantassist.io/tracker/pkg/tracker"
+	"github.com/tutman96/fantassist.io/tracker/pkg/ws"
+)
+
+var (
+	replayPath   = flag.String("replay", "", "play back a video file or directory of PNG frames instead of using the camera")
+	replayFPS    = flag.Float64("replay-fps", 0, "frame rate to play back at, defaults to the video's frame rate")
+	useSynthetic = flag.Bool("synthetic", false, "render a virtual table with moving tokens instead of using the camera")
+	disableBLE   = flag.Bool("disable-ble", false, "only accept connections over WebSocket")
+	wsOrigins    = flag.String("ws-origins", "https://app.fantassist.io,http://localhost:3000", "comma separated origins of the pages allowed to connect over WebSocket")
+	dataDir      = flag.String("data-dir", "data", "directory to store calibrations in")
 )
 
 func main() {
+	flag.Parse()
+
 	ctx, cancel := context.WithCancel(context.Background())
 	go func() {
 		interrupt := make(chan os.Signal, 1)
@@ -20,11 +45,55 @@ func main() {
 		cancel()
 	}()
 
-	sm, err := pkg.NewStateMachine()
+	wsChannel := ws.NewWebSocketChannel(strings.Split(*wsOrigins, ","))
+	channels := []channel.Channel{wsChannel}
+	if !*disableBLE {
+		bleChannel, err := ble.NewBleChannel()
+		if err != nil {
+			panic(err)
+		}
+		channels = append(channels, bleChannel)
+	}
+
+	resolution := image.Point{X: 1280, Y: 720}
+
+	var camera tracker.FrameSource
+	var err error
+	if *replayPath != "" {
+		camera, err = replay.Open(*replayPath, resolution, *replayFPS)
+	} else if *useSynthetic {
+		camera = synthetic.NewCamera(synthetic.DemoScene(resolution), resolution, 30)
+	} else {
+		camera, err = libcamera.Open(resolution)
+	}
 	if err != nil {
 		panic(err)
 	}
 
+	poseCalibration, err := calib3d.LoadPoseCalibration(pkg.PoseCalibrationPath(*dataDir), resolution)
+	if errors.Is(err, fs.ErrNotExist) {
+		fmt.Println("No pose calibration found, calibration is required before tracking")
+	} else if err != nil {
+		fmt.Println("Error loading pose calibration:", err)
+	} else {
+		fmt.Println("Loaded pose calibration from", poseCalibration.CalibratedAt)
+	}
+
+	intrinsics, err := calib3d.LoadCameraIntrinsics(pkg.CameraIntrinsicsPath(*dataDir), resolution)
+	if errors.Is(err, fs.ErrNotExist) {
+		fmt.Println("No camera intrinsics found, lens distortion will not be corrected")
+	} else if err != nil {
+		fmt.Println("Error loading camera intrinsics:", err)
+	}
+
+	t := tracker.NewTracker(resolution, camera, poseCalibration, intrinsics)
+
+	sm := pkg.NewStateMachine(channel.NewMultiChannel(channels...), t, *dataDir)
+
+	http.Handle("/ws", wsChannel)
+	http.HandleFunc("/mjpeg", t.HandleMJPEG)
+	go http.ListenAndServe(":8080", nil)
+
 	err = sm.Start(ctx)
 	if err != nil {
 		panic(err)
@@ -4,6 +4,7 @@ go 1.22.4
 
 require (
 	github.com/google/uuid v1.1.1
+	github.com/gorilla/websocket v1.5.3
 	github.com/muka/go-bluetooth v0.0.0-20240115085408-dfdf79b8f61d
 	go.einride.tech/pid v0.1.2
 	gocv.io/x/gocv v0.37.0
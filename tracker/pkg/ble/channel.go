@@ -2,13 +2,17 @@ package ble
 
 import (
 	"context"
+	"encoding/binary"
 	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
 
-	"github.com/google/uuid"
 	"github.com/muka/go-bluetooth/api/service"
 	"github.com/muka/go-bluetooth/bluez/profile/agent"
 	"github.com/muka/go-bluetooth/bluez/profile/gatt"
 	"github.com/muka/go-bluetooth/hw"
+	"github.com/tutman96/fantassist.io/tracker/pkg/channel"
 	"github.com/tutman96/fantassist.io/tracker/protos"
 	"google.golang.org/protobuf/proto"
 )
@@ -18,12 +22,24 @@ type BleChannel struct {
 	readChar  *service.Char
 	writeChar *service.Char
 
-	Connected                     bool
-	connectionStateChangeChannels []chan bool
+	// mutex guards Connected and disconnected, which change when the client
+	// subscribes to or unsubscribes from notifications
+	mutex               sync.Mutex
+	Connected           bool
+	connectionListeners channel.ConnectionListeners
+	// disconnected is closed when the client unsubscribes, which stops the
+	// goroutine writing outbound packets and any SendPacket waiting on it
+	disconnected chan struct{}
+
+	// chunkSize is the maximum number of bytes sent in a single notification,
+	// including the frame header. It is sized from the MTU once the client
+	// reads the read characteristic, see onRead.
+	chunkSize        atomic.Int32
+	outboundSequence uint16
+	reassembler      *reassembler
 
 	outboundPacketChannel chan *protos.Packet
-	requestHandlers       []func(*protos.Request) *protos.Response
-	requestChannels       map[string]chan *protos.Response
+	dispatcher            *channel.Dispatcher
 }
 
 const (
@@ -117,14 +133,22 @@ func NewBleChannel() (*BleChannel, error) {
 		readChar:  readChar,
 		writeChar: writeChar,
 
-		Connected:                     false,
-		connectionStateChangeChannels: []chan bool{},
+		Connected:    false,
+		disconnected: make(chan struct{}),
+
+		reassembler: newReassembler(defaultReassemblyTimeout),
 
 		outboundPacketChannel: make(chan *protos.Packet),
-		requestHandlers:       make([]func(*protos.Request) *protos.Response, 0),
-		requestChannels:       make(map[string]chan *protos.Response),
+		dispatcher:            channel.NewDispatcher(),
 	}
 
+	close(b.disconnected)
+	b.chunkSize.Store(defaultChunkSize)
+	// The tracker has always responded to every request over Bluetooth, and
+	// older apps don't set a request ID
+	b.dispatcher.RespondToBroadcasts = true
+
+	readChar.OnRead(b.onRead)
 	readChar.OnNotify(b.onNotify)
 	writeChar.OnWrite(b.onWrite)
 
@@ -152,71 +176,116 @@ func (manager *BleChannel) Start(ctx context.Context) error {
 }
 
 func (manager *BleChannel) OnConnectionStateChange() <-chan bool {
-	c := make(chan bool)
-	manager.connectionStateChangeChannels = append(manager.connectionStateChangeChannels, c)
-	return c
+	return manager.connectionListeners.Add()
 }
 
-func (manager *BleChannel) AddRequestHandler(handler func(req *protos.Request) *protos.Response) {
-	manager.requestHandlers = append(manager.requestHandlers, handler)
+func (manager *BleChannel) AddRequestHandler(handler channel.RequestHandler) {
+	manager.dispatcher.AddRequestHandler(handler)
 }
 
 func (manager *BleChannel) Request(req *protos.Request) *protos.Response {
-	id := uuid.New()
-	packet := &protos.Packet{
-		RequestId: id.String(),
-		Message: &protos.Packet_Request{
-			Request: req,
-		},
-	}
-
-	response := make(chan *protos.Response)
-	manager.requestChannels[id.String()] = response
-
+	packet, response := manager.dispatcher.NewRequest(req)
 	manager.SendPacket(packet)
 	return <-response
 }
 
+// SendPacket sends the packet to the connected client. Packets sent while no
+// client is subscribed to notifications are dropped.
 func (manager *BleChannel) SendPacket(packet *protos.Packet) {
-	manager.outboundPacketChannel <- packet
+	manager.mutex.Lock()
+	disconnected := manager.disconnected
+	manager.mutex.Unlock()
+
+	select {
+	case manager.outboundPacketChannel <- packet:
+	case <-disconnected:
+		fmt.Println("Dropping packet, no client is connected:", packet)
+	}
 }
 
 func (manager *BleChannel) onNotify(_ *service.Char, notify bool) error {
-	if notify != manager.Connected {
-		manager.Connected = notify
-		for _, c := range manager.connectionStateChangeChannels {
-			c <- notify
-		}
-	}
-
 	fmt.Println("Notify", notify)
 
+	manager.mutex.Lock()
+	if notify == manager.Connected {
+		manager.mutex.Unlock()
+		return nil
+	}
+
+	manager.Connected = notify
 	if notify {
-		go func() {
-			for packet := range manager.outboundPacketChannel {
-				bytes, err := proto.Marshal(packet)
-				if err != nil {
-					fmt.Println("Error marshalling packet:", err)
-					continue
-				}
-				fmt.Println("-> Sending packet", packet, len(bytes))
-				manager.readChar.WriteValue(bytes, map[string]interface{}{
+		manager.disconnected = make(chan struct{})
+		go manager.writePackets(manager.disconnected)
+	} else {
+		close(manager.disconnected)
+		manager.reassembler.reset()
+		manager.chunkSize.Store(defaultChunkSize)
+	}
+	manager.mutex.Unlock()
+
+	manager.connectionListeners.Notify(notify)
+
+	return nil
+}
+
+// writePackets notifies the client of outbound packets until disconnected is
+// closed
+func (manager *BleChannel) writePackets(disconnected <-chan struct{}) {
+	for {
+		select {
+		case <-disconnected:
+			return
+		case packet := <-manager.outboundPacketChannel:
+			bytes, err := proto.Marshal(packet)
+			if err != nil {
+				fmt.Println("Error marshalling packet:", err)
+				continue
+			}
+
+			chunks, err := fragmentPacket(manager.outboundSequence, bytes, int(manager.chunkSize.Load()))
+			if err != nil {
+				fmt.Println("Error fragmenting packet:", err)
+				continue
+			}
+			manager.outboundSequence++
+
+			fmt.Println("-> Sending packet", packet, len(bytes), "in", len(chunks), "chunks")
+			for _, chunk := range chunks {
+				manager.readChar.WriteValue(chunk, map[string]interface{}{
 					"device": "server",
 					"link":   "server",
 				})
 			}
-		}()
-	} else {
-		close(manager.outboundPacketChannel)
-		manager.outboundPacketChannel = make(chan *protos.Packet)
+		}
 	}
+}
 
-	return nil
+// onRead sizes chunks from the MTU BlueZ reports for the client's link and
+// replies with the chunk size as a big endian uint16, so the client can size
+// the chunks it writes the same way
+func (manager *BleChannel) onRead(_ *service.Char, options map[string]interface{}) ([]byte, error) {
+	if mtu, ok := options["mtu"].(uint16); ok {
+		manager.chunkSize.Store(int32(chunkSizeForMTU(int(mtu))))
+	}
+
+	value := make([]byte, 2)
+	binary.BigEndian.PutUint16(value, uint16(manager.chunkSize.Load()))
+	return value, nil
 }
 
 func (manager *BleChannel) onWrite(_ *service.Char, value []byte) ([]byte, error) {
+	bytes, err := manager.reassembler.add(value, time.Now())
+	if err != nil {
+		fmt.Println("Error reassembling packet:", err)
+		return []byte{}, nil
+	}
+	if bytes == nil {
+		// Wait for the rest of the chunks
+		return []byte{}, nil
+	}
+
 	packet := &protos.Packet{}
-	err := proto.Unmarshal(value, packet)
+	err = proto.Unmarshal(bytes, packet)
 	if err != nil {
 		fmt.Println("Error unmarshalling packet:", err)
 		return []byte{}, nil
@@ -224,29 +293,9 @@ func (manager *BleChannel) onWrite(_ *service.Char, value []byte) ([]byte, error
 
 	fmt.Println("<- Received packet", packet)
 
-	switch packet.Message.(type) {
-	case *protos.Packet_Request:
-		req := packet.GetRequest()
-		for _, handler := range manager.requestHandlers {
-			response := handler(req)
-			if response != nil {
-				manager.SendPacket(&protos.Packet{
-					RequestId: packet.RequestId,
-					Message: &protos.Packet_Response{
-						Response: response,
-					},
-				})
-				return []byte{}, nil
-			}
-		}
-		fmt.Println("No handler found for request", req)
-	case *protos.Packet_Response:
-		c, ok := manager.requestChannels[packet.RequestId]
-		if ok {
-			c <- packet.GetResponse()
-		} else {
-			fmt.Println("No channel found for request ID", packet.RequestId)
-		}
+	response := manager.dispatcher.HandlePacket(packet)
+	if response != nil {
+		manager.SendPacket(response)
 	}
 
 	return []byte{}, nil
@@ -0,0 +1,208 @@
+package ble
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Packets are split into chunks that each fit within a single GATT write or
+// notification. Every chunk is prefixed with a header:
+//
+//	[0:2]  sequence number of the packet the chunk belongs to
+//	[2:6]  index of the chunk within the packet
+//	[6:10] total number of chunks in the packet
+//
+// All header fields are big endian.
+const (
+	frameHeaderSize = 10
+
+	// The largest packet sent either way. Requests are small apart from display
+	// mask images, which this leaves room for.
+	maxPacketSize = 1 << 20
+	// Enough chunks for a maxPacketSize packet at the default chunk size
+	maxChunkCount = (maxPacketSize + defaultChunkSize - frameHeaderSize - 1) / (defaultChunkSize - frameHeaderSize)
+
+	// Limits on what the reassembler holds on to for packets that haven't
+	// completed. Starting a packet beyond maxPartialPackets drops the one that
+	// was updated least recently.
+	maxPartialPackets = 4
+	maxBufferedBytes  = 2 * maxPacketSize
+
+	// The minimum ATT MTU is 23 bytes, 3 of which are used by the ATT header.
+	// Using it as the default means packets cross the link before the client
+	// has learnt the MTU it negotiated, see chunkSizeForMTU.
+	defaultChunkSize = 20
+	attHeaderSize    = 3
+	// Attribute values can't be longer than 512 bytes, whatever the MTU
+	maxChunkSize = 512
+
+	defaultReassemblyTimeout = 5 * time.Second
+)
+
+// chunkSizeForMTU returns the largest chunk that fits in a single write or
+// notification with the given ATT MTU
+func chunkSizeForMTU(mtu int) int {
+	return max(defaultChunkSize, min(mtu-attHeaderSize, maxChunkSize))
+}
+
+// fragmentPacket splits the encoded packet into chunks of at most chunkSize
+// bytes, including the frame header
+func fragmentPacket(sequence uint16, data []byte, chunkSize int) ([][]byte, error) {
+	payloadSize := chunkSize - frameHeaderSize
+	if payloadSize <= 0 {
+		return nil, fmt.Errorf("chunk size %d is too small to fit the frame header", chunkSize)
+	}
+
+	count := (len(data) + payloadSize - 1) / payloadSize
+	if count == 0 {
+		count = 1
+	}
+	if count > maxChunkCount {
+		return nil, fmt.Errorf("packet of %d bytes needs %d chunks, more than the maximum of %d", len(data), count, maxChunkCount)
+	}
+
+	chunks := make([][]byte, count)
+	for i := 0; i < count; i++ {
+		start := i * payloadSize
+		end := min(start+payloadSize, len(data))
+
+		chunk := make([]byte, frameHeaderSize+end-start)
+		binary.BigEndian.PutUint16(chunk[0:2], sequence)
+		binary.BigEndian.PutUint32(chunk[2:6], uint32(i))
+		binary.BigEndian.PutUint32(chunk[6:10], uint32(count))
+		copy(chunk[frameHeaderSize:], data[start:end])
+
+		chunks[i] = chunk
+	}
+
+	return chunks, nil
+}
+
+type partialPacket struct {
+	count     int
+	chunks    map[int][]byte
+	size      int
+	updatedAt time.Time
+}
+
+// reassembler collects chunks produced by fragmentPacket until every chunk of
+// a packet has arrived. Packets that stop receiving chunks for longer than the
+// timeout are discarded.
+type reassembler struct {
+	mutex    sync.Mutex
+	timeout  time.Duration
+	partials map[uint16]*partialPacket
+	// buffered is the number of payload bytes held across all partials
+	buffered int
+}
+
+func newReassembler(timeout time.Duration) *reassembler {
+	return &reassembler{
+		timeout:  timeout,
+		partials: make(map[uint16]*partialPacket),
+	}
+}
+
+// add stores the chunk and returns the full packet once its final chunk has
+// been received, otherwise nil
+func (r *reassembler) add(chunk []byte, now time.Time) ([]byte, error) {
+	if len(chunk) < frameHeaderSize {
+		return nil, fmt.Errorf("chunk of %d bytes is smaller than the frame header", len(chunk))
+	}
+
+	sequence := binary.BigEndian.Uint16(chunk[0:2])
+	index := int(binary.BigEndian.Uint32(chunk[2:6]))
+	count := int(binary.BigEndian.Uint32(chunk[6:10]))
+	if count == 0 || count > maxChunkCount || index >= count {
+		return nil, fmt.Errorf("invalid chunk %d of %d for packet %d", index, count, sequence)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.expire(now)
+
+	partial, ok := r.partials[sequence]
+	if ok && partial.count != count {
+		// The sequence number wrapped around onto a packet that never completed
+		r.drop(sequence)
+		ok = false
+	}
+	if !ok {
+		if len(r.partials) >= maxPartialPackets {
+			r.dropOldest()
+		}
+		partial = &partialPacket{
+			count:  count,
+			chunks: make(map[int][]byte),
+		}
+		r.partials[sequence] = partial
+	}
+
+	payload := chunk[frameHeaderSize:]
+	growth := len(payload) - len(partial.chunks[index])
+	if partial.size+growth > maxPacketSize || r.buffered+growth > maxBufferedBytes {
+		r.drop(sequence)
+		return nil, fmt.Errorf("dropping packet %d, it is larger than can be buffered", sequence)
+	}
+	partial.chunks[index] = append([]byte{}, payload...)
+	partial.size += growth
+	partial.updatedAt = now
+	r.buffered += growth
+
+	if len(partial.chunks) < count {
+		return nil, nil
+	}
+
+	r.drop(sequence)
+
+	data := make([]byte, 0, partial.size)
+	for i := 0; i < count; i++ {
+		data = append(data, partial.chunks[i]...)
+	}
+
+	return data, nil
+}
+
+// reset discards all partially received packets
+func (r *reassembler) reset() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.partials = make(map[uint16]*partialPacket)
+	r.buffered = 0
+}
+
+func (r *reassembler) expire(now time.Time) {
+	for sequence, partial := range r.partials {
+		if now.Sub(partial.updatedAt) > r.timeout {
+			fmt.Println("Dropping partial packet", sequence, "after receiving", len(partial.chunks), "of", partial.count, "chunks")
+			r.drop(sequence)
+		}
+	}
+}
+
+// dropOldest discards the partial packet that was updated least recently
+func (r *reassembler) dropOldest() {
+	var oldest *partialPacket
+	var oldestSequence uint16
+	for sequence, partial := range r.partials {
+		if oldest == nil || partial.updatedAt.Before(oldest.updatedAt) {
+			oldest = partial
+			oldestSequence = sequence
+		}
+	}
+	if oldest != nil {
+		fmt.Println("Dropping partial packet", oldestSequence, "to make room for another")
+		r.drop(oldestSequence)
+	}
+}
+
+func (r *reassembler) drop(sequence uint16) {
+	if partial, ok := r.partials[sequence]; ok {
+		r.buffered -= partial.size
+		delete(r.partials, sequence)
+	}
+}
@@ -0,0 +1,191 @@
+package ble
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+)
+
+func testPayload(size int) []byte {
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(i * 7)
+	}
+	return data
+}
+
+func TestFramingRoundTrip(t *testing.T) {
+	now := time.Now()
+	for _, size := range []int{0, 1, 9, 10, 11, 1000} {
+		data := testPayload(size)
+		chunks, err := fragmentPacket(42, data, defaultChunkSize)
+		if err != nil {
+			t.Fatalf("fragmenting %d bytes: %v", size, err)
+		}
+
+		r := newReassembler(defaultReassemblyTimeout)
+		for i, chunk := range chunks {
+			packet, err := r.add(chunk, now)
+			if err != nil {
+				t.Fatalf("adding chunk %d of %d bytes: %v", i, size, err)
+			}
+			if i < len(chunks)-1 && packet != nil {
+				t.Fatalf("packet of %d bytes completed after %d of %d chunks", size, i+1, len(chunks))
+			}
+			if i == len(chunks)-1 && !bytes.Equal(packet, data) {
+				t.Errorf("reassembled %d bytes, expected %d", len(packet), size)
+			}
+		}
+	}
+}
+
+func TestFramingOutOfOrderAndDuplicates(t *testing.T) {
+	now := time.Now()
+	data := testPayload(100)
+	chunks, err := fragmentPacket(1, data, defaultChunkSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := newReassembler(defaultReassemblyTimeout)
+	var packet []byte
+	for i := len(chunks) - 1; i >= 0; i-- {
+		packet, err = r.add(chunks[i], now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i > 0 {
+			// Receiving a chunk twice mustn't count it twice
+			packet, err = r.add(chunks[i], now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if packet != nil {
+				t.Fatalf("packet completed after a duplicate of chunk %d", i)
+			}
+		}
+	}
+
+	if !bytes.Equal(packet, data) {
+		t.Errorf("reassembled packet doesn't match the one sent")
+	}
+	if r.buffered != 0 || len(r.partials) != 0 {
+		t.Errorf("expected nothing buffered after the packet completed, got %d bytes in %d packets", r.buffered, len(r.partials))
+	}
+}
+
+func TestFramingSequenceWrap(t *testing.T) {
+	now := time.Now()
+	r := newReassembler(defaultReassemblyTimeout)
+
+	// An incomplete packet that the sequence number wraps back around onto
+	stale, _ := fragmentPacket(0, testPayload(100), defaultChunkSize)
+	if _, err := r.add(stale[0], now); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, sequence := range []uint16{65534, 65535, 0} {
+		data := testPayload(30 + int(sequence%7))
+		chunks, _ := fragmentPacket(sequence, data, defaultChunkSize)
+
+		var packet []byte
+		for _, chunk := range chunks {
+			var err error
+			packet, err = r.add(chunk, now)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		if !bytes.Equal(packet, data) {
+			t.Errorf("packet %d doesn't match the one sent", sequence)
+		}
+	}
+
+	if r.buffered != 0 || len(r.partials) != 0 {
+		t.Errorf("expected the stale packet to be replaced, got %d bytes in %d packets", r.buffered, len(r.partials))
+	}
+}
+
+func TestFramingExpiry(t *testing.T) {
+	now := time.Now()
+	r := newReassembler(time.Second)
+
+	chunks, _ := fragmentPacket(5, testPayload(100), defaultChunkSize)
+	if _, err := r.add(chunks[0], now); err != nil {
+		t.Fatal(err)
+	}
+
+	// Any chunk arriving after the timeout expires the incomplete packet
+	other, _ := fragmentPacket(6, testPayload(100), defaultChunkSize)
+	if _, err := r.add(other[0], now.Add(2*time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := r.partials[5]; ok {
+		t.Errorf("expected packet 5 to expire")
+	}
+
+	// so its remaining chunks can't complete it
+	for _, chunk := range chunks[1:] {
+		packet, err := r.add(chunk, now.Add(2*time.Second))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if packet != nil {
+			t.Errorf("expired packet completed")
+		}
+	}
+}
+
+func TestFramingLimits(t *testing.T) {
+	now := time.Now()
+	r := newReassembler(defaultReassemblyTimeout)
+
+	chunk := make([]byte, frameHeaderSize+1)
+	binary.BigEndian.PutUint32(chunk[6:10], maxChunkCount+1)
+	if _, err := r.add(chunk, now); err == nil {
+		t.Errorf("expected a chunk count above %d to be rejected", maxChunkCount)
+	}
+
+	// Starting more packets than maxPartialPackets drops the oldest
+	for sequence := 0; sequence <= maxPartialPackets; sequence++ {
+		chunks, _ := fragmentPacket(uint16(sequence), testPayload(100), defaultChunkSize)
+		if _, err := r.add(chunks[0], now.Add(time.Duration(sequence)*time.Millisecond)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(r.partials) != maxPartialPackets {
+		t.Errorf("expected %d partial packets, got %d", maxPartialPackets, len(r.partials))
+	}
+	if _, ok := r.partials[0]; ok {
+		t.Errorf("expected the oldest partial packet to be dropped")
+	}
+
+	// A packet can't buffer more than maxPacketSize
+	r.reset()
+	binary.BigEndian.PutUint16(chunk[0:2], 9)
+	binary.BigEndian.PutUint32(chunk[6:10], 2)
+	big := append(chunk[:frameHeaderSize:frameHeaderSize], make([]byte, maxPacketSize)...)
+	if _, err := r.add(big, now); err != nil {
+		t.Fatal(err)
+	}
+	binary.BigEndian.PutUint32(chunk[2:6], 1)
+	if _, err := r.add(chunk, now); err == nil {
+		t.Errorf("expected a packet larger than %d bytes to be rejected", maxPacketSize)
+	}
+	if r.buffered != 0 || len(r.partials) != 0 {
+		t.Errorf("expected the oversized packet to be dropped, got %d bytes in %d packets", r.buffered, len(r.partials))
+	}
+
+	// nor can all packets together buffer more than maxBufferedBytes
+	for sequence := 0; sequence < maxBufferedBytes/maxPacketSize; sequence++ {
+		binary.BigEndian.PutUint16(big[0:2], uint16(sequence))
+		if _, err := r.add(big, now); err != nil {
+			t.Fatal(err)
+		}
+	}
+	binary.BigEndian.PutUint16(chunk[0:2], 100)
+	if _, err := r.add(chunk, now); err == nil {
+		t.Errorf("expected buffering more than %d bytes to be rejected", maxBufferedBytes)
+	}
+}
@@ -1,23 +1,92 @@
 package calib3d
 
 import (
+	"image"
+	"slices"
+	"time"
+
 	"gocv.io/x/gocv"
 )
 
 type PoseCalibration struct {
-	FoundCorners  []int32
+	FoundCorners []int32
+	// CornerLocations are the pixel locations of the FoundCorners averaged over
+	// recent frames, and CornerDeviations how much each has moved in pixels,
+	// in the same order
+	CornerLocations  []gocv.Point2f
+	CornerDeviations []float64
+
+	// MarkerIds are the reference markers the homography was computed from,
+	// and RealCorners their positions on the table
+	MarkerIds   []int32
+	RealCorners []gocv.Point3f
+
+	// ReprojectionError is the RMS distance, in table units, between the real
+	// corners and the detected corners mapped through the homography.
+	// InlierMask marks which corners RANSAC used for the homography, and
+	// Residuals is each corner's distance from where the homography maps it,
+	// including the corners that were left out.
+	ReprojectionError float64
+	InlierMask        []bool
+	Residuals         []float64
+
+	Resolution    image.Point
+	CalibratedAt  time.Time
 	Yaw           float64
 	Pitch         float64
 	Roll          float64
 	HomographyMat gocv.Mat
+
+	// The camera's rotation and translation relative to the table, and the
+	// camera matrix they were solved with. These are empty unless the camera's
+	// intrinsics were known when calibrating.
+	RotationMat    gocv.Mat
+	TranslationMat gocv.Mat
+	CameraMatrix   gocv.Mat
+
+	// NeedsRecalibration is set when the calibration was invalidated because
+	// the camera's intrinsics changed after it was made
+	NeedsRecalibration bool
 }
 
 func NewPoseCalibration() *PoseCalibration {
 	return &PoseCalibration{
-		HomographyMat: gocv.NewMat(),
+		HomographyMat:  gocv.NewMat(),
+		RotationMat:    gocv.NewMat(),
+		TranslationMat: gocv.NewMat(),
+		CameraMatrix:   gocv.NewMat(),
 	}
 }
 
+// Invalidate discards the homography and extrinsics, which were computed with
+// pixels undistorted by intrinsics that no longer apply
+func (p *PoseCalibration) Invalidate() {
+	p.HomographyMat.Close()
+	p.HomographyMat = gocv.NewMat()
+	p.ClearExtrinsics()
+	p.NeedsRecalibration = true
+}
+
+// Clone returns a deep copy of the calibration, which has to be closed
+func (p *PoseCalibration) Clone() *PoseCalibration {
+	clone := *p
+	clone.FoundCorners = slices.Clone(p.FoundCorners)
+	clone.CornerLocations = slices.Clone(p.CornerLocations)
+	clone.CornerDeviations = slices.Clone(p.CornerDeviations)
+	clone.MarkerIds = slices.Clone(p.MarkerIds)
+	clone.RealCorners = slices.Clone(p.RealCorners)
+	clone.InlierMask = slices.Clone(p.InlierMask)
+	clone.Residuals = slices.Clone(p.Residuals)
+	clone.HomographyMat = p.HomographyMat.Clone()
+	clone.RotationMat = p.RotationMat.Clone()
+	clone.TranslationMat = p.TranslationMat.Clone()
+	clone.CameraMatrix = p.CameraMatrix.Clone()
+	return &clone
+}
+
 func (p *PoseCalibration) Close() {
 	p.HomographyMat.Close()
+	p.RotationMat.Close()
+	p.TranslationMat.Close()
+	p.CameraMatrix.Close()
 }
@@ -0,0 +1,128 @@
+package calib3d
+
+import (
+	"fmt"
+	"math"
+
+	"gocv.io/x/gocv"
+)
+
+// cv::SOLVEPNP_IPPE, which gocv doesn't export. It is suited to planar targets
+// such as the markers on the table.
+const solvePnPIPPE = 6
+
+// SolveExtrinsics computes the camera's rotation and translation relative to
+// the table from the pixel locations of points with known table coordinates.
+// The pixels are as seen by the camera, before undistortion. Yaw, Pitch and
+// Roll are updated to match. Any previous extrinsics are cleared first, so
+// none are left if solving fails.
+func (p *PoseCalibration) SolveExtrinsics(realPoints []gocv.Point3f, imagePoints []gocv.Point2f, intrinsics *CameraIntrinsics) error {
+	p.ClearExtrinsics()
+	if intrinsics == nil || intrinsics.CameraMatrix.Empty() {
+		return fmt.Errorf("camera intrinsics are required to solve for the camera's pose")
+	}
+
+	objectVector := gocv.NewPoint3fVectorFromPoints(realPoints)
+	defer objectVector.Close()
+	imageVector := gocv.NewPoint2fVectorFromPoints(imagePoints)
+	defer imageVector.Close()
+
+	rvec := gocv.NewMat()
+	defer rvec.Close()
+	tvec := gocv.NewMat()
+
+	if !gocv.SolvePnP(objectVector, imageVector, intrinsics.CameraMatrix, intrinsics.DistCoeffs, &rvec, &tvec, false, solvePnPIPPE) {
+		tvec.Close()
+		return fmt.Errorf("unable to solve for the camera's pose")
+	}
+
+	rotation := gocv.NewMat()
+	gocv.Rodrigues(rvec, &rotation)
+
+	p.RotationMat.Close()
+	p.RotationMat = rotation
+	p.TranslationMat.Close()
+	p.TranslationMat = tvec
+	p.CameraMatrix.Close()
+	p.CameraMatrix = intrinsics.CameraMatrix.Clone()
+
+	// Decompose the table to camera rotation as Z (yaw), Y (pitch), X (roll)
+	r, err := matToRows(rotation)
+	if err != nil {
+		p.ClearExtrinsics()
+		return err
+	}
+	sy := math.Sqrt(r[0][0]*r[0][0] + r[1][0]*r[1][0])
+	p.Yaw = math.Atan2(r[1][0], r[0][0]) * 180 / math.Pi
+	p.Pitch = math.Atan2(-r[2][0], sy) * 180 / math.Pi
+	p.Roll = math.Atan2(r[2][1], r[2][2]) * 180 / math.Pi
+
+	return nil
+}
+
+// ClearExtrinsics forgets the camera's pose, e.g. when the homography it was
+// solved alongside is replaced
+func (p *PoseCalibration) ClearExtrinsics() {
+	p.RotationMat.Close()
+	p.RotationMat = gocv.NewMat()
+	p.TranslationMat.Close()
+	p.TranslationMat = gocv.NewMat()
+	p.CameraMatrix.Close()
+	p.CameraMatrix = gocv.NewMat()
+	p.Yaw = 0
+	p.Pitch = 0
+	p.Roll = 0
+}
+
+// HasExtrinsics returns whether the camera's full pose is known, which is
+// needed to locate points above the table
+func (p *PoseCalibration) HasExtrinsics() bool {
+	return !p.RotationMat.Empty() && !p.TranslationMat.Empty() && !p.CameraMatrix.Empty()
+}
+
+// projectAtHeight casts a ray from the camera through the undistorted pixel
+// and intersects it with the plane height above the table
+func (p *PoseCalibration) projectAtHeight(pixel gocv.Point2f, height float64) gocv.Point3f {
+	var r [3][3]float64
+	for i := range r {
+		for j := range r[i] {
+			r[i][j] = p.RotationMat.GetDoubleAt(i, j)
+		}
+	}
+	t := [3]float64{
+		p.TranslationMat.GetDoubleAt(0, 0),
+		p.TranslationMat.GetDoubleAt(1, 0),
+		p.TranslationMat.GetDoubleAt(2, 0),
+	}
+
+	fx := p.CameraMatrix.GetDoubleAt(0, 0)
+	fy := p.CameraMatrix.GetDoubleAt(1, 1)
+	cx := p.CameraMatrix.GetDoubleAt(0, 2)
+	cy := p.CameraMatrix.GetDoubleAt(1, 2)
+
+	ray := [3]float64{
+		(float64(pixel.X) - cx) / fx,
+		(float64(pixel.Y) - cy) / fy,
+		1,
+	}
+
+	// Camera center and ray direction in table coordinates, using R^T
+	var center, direction [3]float64
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			center[i] -= r[j][i] * t[j]
+			direction[i] += r[j][i] * ray[j]
+		}
+	}
+
+	// The table's z axis points away from the camera, so points above the
+	// table have a negative z
+	z := -height
+	s := (z - center[2]) / direction[2]
+
+	return gocv.Point3f{
+		X: float32(center[0] + s*direction[0]),
+		Y: float32(center[1] + s*direction[1]),
+		Z: 0,
+	}
+}
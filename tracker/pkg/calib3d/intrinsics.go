@@ -0,0 +1,185 @@
+package calib3d
+
+import (
+	"encoding/json"
+	"fmt"
+	"image"
+	"os"
+	"time"
+
+	"gocv.io/x/gocv"
+)
+
+// cameraIntrinsicsVersion is bumped whenever the stored format changes in a way
+// older calibrations can't be read with
+const cameraIntrinsicsVersion = 1
+
+// CameraIntrinsics describes the camera's lens, used to undo the distortion of
+// wide angle lenses before mapping pixels onto the table
+type CameraIntrinsics struct {
+	Resolution        image.Point
+	CalibratedAt      time.Time
+	ReprojectionError float64
+	CameraMatrix      gocv.Mat
+	DistCoeffs        gocv.Mat
+}
+
+func (c *CameraIntrinsics) Close() {
+	c.CameraMatrix.Close()
+	c.DistCoeffs.Close()
+}
+
+// UndistortPoint maps a pixel in the camera's image to where it would be seen by
+// an ideal pinhole camera with the same camera matrix. Points are returned
+// unchanged if there is no calibration.
+func (c *CameraIntrinsics) UndistortPoint(pixel gocv.Point2f) gocv.Point2f {
+	if c == nil || c.CameraMatrix.Empty() {
+		return pixel
+	}
+
+	src := gocv.NewMatWithSize(1, 1, gocv.MatTypeCV32FC2)
+	defer src.Close()
+	src.SetFloatAt(0, 0, pixel.X)
+	src.SetFloatAt(0, 1, pixel.Y)
+
+	dst := gocv.NewMat()
+	defer dst.Close()
+
+	rectification := gocv.NewMat()
+	defer rectification.Close()
+
+	gocv.UndistortPoints(src, &dst, c.CameraMatrix, c.DistCoeffs, rectification, c.CameraMatrix)
+
+	return gocv.Point2f{
+		X: dst.GetFloatAt(0, 0),
+		Y: dst.GetFloatAt(0, 1),
+	}
+}
+
+// DistortPoint is the inverse of UndistortPoint, mapping a pixel seen by an
+// ideal pinhole camera to where the lens puts it in the camera's image
+func (c *CameraIntrinsics) DistortPoint(pixel gocv.Point2f) gocv.Point2f {
+	if c == nil || c.CameraMatrix.Empty() {
+		return pixel
+	}
+
+	fx, fy := c.CameraMatrix.GetDoubleAt(0, 0), c.CameraMatrix.GetDoubleAt(1, 1)
+	cx, cy := c.CameraMatrix.GetDoubleAt(0, 2), c.CameraMatrix.GetDoubleAt(1, 2)
+
+	// k1, k2, p1, p2 and k3, any of which may be missing
+	coefficients := [5]float64{}
+	for i := 0; i < len(coefficients) && i < int(c.DistCoeffs.Total()); i++ {
+		if c.DistCoeffs.Rows() == 1 {
+			coefficients[i] = c.DistCoeffs.GetDoubleAt(0, i)
+		} else {
+			coefficients[i] = c.DistCoeffs.GetDoubleAt(i, 0)
+		}
+	}
+	k1, k2, p1, p2, k3 := coefficients[0], coefficients[1], coefficients[2], coefficients[3], coefficients[4]
+
+	x := (float64(pixel.X) - cx) / fx
+	y := (float64(pixel.Y) - cy) / fy
+	r2 := x*x + y*y
+	radial := 1 + k1*r2 + k2*r2*r2 + k3*r2*r2*r2
+	distortedX := x*radial + 2*p1*x*y + p2*(r2+2*x*x)
+	distortedY := y*radial + p1*(r2+2*y*y) + 2*p2*x*y
+
+	return gocv.Point2f{
+		X: float32(distortedX*fx + cx),
+		Y: float32(distortedY*fy + cy),
+	}
+}
+
+type storedCameraIntrinsics struct {
+	Version           int         `json:"version"`
+	CalibratedAt      time.Time   `json:"calibratedAt"`
+	Resolution        storedSize  `json:"resolution"`
+	ReprojectionError float64     `json:"reprojectionError"`
+	CameraMatrix      [][]float64 `json:"cameraMatrix"`
+	DistCoeffs        [][]float64 `json:"distCoeffs"`
+}
+
+func (c *CameraIntrinsics) MarshalJSON() ([]byte, error) {
+	cameraMatrix, err := matToRows(c.CameraMatrix)
+	if err != nil {
+		return nil, err
+	}
+	distCoeffs, err := matToRows(c.DistCoeffs)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(storedCameraIntrinsics{
+		Version:           cameraIntrinsicsVersion,
+		CalibratedAt:      c.CalibratedAt,
+		Resolution:        storedSize{Width: c.Resolution.X, Height: c.Resolution.Y},
+		ReprojectionError: c.ReprojectionError,
+		CameraMatrix:      cameraMatrix,
+		DistCoeffs:        distCoeffs,
+	})
+}
+
+func (c *CameraIntrinsics) UnmarshalJSON(data []byte) error {
+	stored := storedCameraIntrinsics{}
+	err := json.Unmarshal(data, &stored)
+	if err != nil {
+		return err
+	}
+
+	if stored.Version != cameraIntrinsicsVersion {
+		return fmt.Errorf("unsupported camera intrinsics version %d", stored.Version)
+	}
+
+	cameraMatrix, err := rowsToMat(stored.CameraMatrix)
+	if err != nil {
+		return err
+	}
+
+	distCoeffs, err := rowsToMat(stored.DistCoeffs)
+	if err != nil {
+		cameraMatrix.Close()
+		return err
+	}
+
+	if c.CameraMatrix.Ptr() != nil {
+		c.CameraMatrix.Close()
+	}
+	if c.DistCoeffs.Ptr() != nil {
+		c.DistCoeffs.Close()
+	}
+	*c = CameraIntrinsics{
+		Resolution:        image.Pt(stored.Resolution.Width, stored.Resolution.Height),
+		CalibratedAt:      stored.CalibratedAt,
+		ReprojectionError: stored.ReprojectionError,
+		CameraMatrix:      cameraMatrix,
+		DistCoeffs:        distCoeffs,
+	}
+	return nil
+}
+
+// Save writes the intrinsics to path, replacing any previous calibration
+func (c *CameraIntrinsics) Save(path string) error {
+	return writeJSON(path, c)
+}
+
+// LoadCameraIntrinsics reads intrinsics written by Save. They are rejected if
+// they were calibrated at a different camera resolution.
+func LoadCameraIntrinsics(path string, resolution image.Point) (*CameraIntrinsics, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &CameraIntrinsics{}
+	err = json.Unmarshal(data, c)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Resolution != resolution {
+		c.Close()
+		return nil, fmt.Errorf("intrinsics were calibrated at %v, camera is running at %v", c.Resolution, resolution)
+	}
+
+	return c, nil
+}
@@ -2,14 +2,20 @@ package calib3d
 
 import (
 	"fmt"
-	"image"
+	"math"
 
 	"gocv.io/x/gocv"
 )
 
-// TODO: support 3d points based on other calibration data
-// TODO: Undistort based on cameraMatrix and distCoeffs
-func (calibration *PoseCalibration) PixelTo3D(pixel image.Point, heightOffset float64) gocv.Point3f {
+// PixelTo3D maps an undistorted pixel (see CameraIntrinsics.UndistortPoint)
+// onto the table. heightOffset is how far above the table the pixel's point
+// is, and the point directly beneath it is returned. Height is only accounted
+// for when the camera's extrinsics are known.
+func (calibration *PoseCalibration) PixelTo3D(pixel gocv.Point2f, heightOffset float64) gocv.Point3f {
+	if heightOffset != 0 && calibration.HasExtrinsics() {
+		return calibration.projectAtHeight(pixel, heightOffset)
+	}
+
 	if calibration.HomographyMat.Empty() {
 		fmt.Println("Warning: Homography matrix is empty")
 		return gocv.Point3f{}
@@ -18,8 +24,8 @@ func (calibration *PoseCalibration) PixelTo3D(pixel image.Point, heightOffset fl
 	// Define the 5th point in image coordinates
 	imagePoint := gocv.NewMatWithSize(1, 1, gocv.MatTypeCV32FC2)
 	defer imagePoint.Close()
-	imagePoint.SetFloatAt(0, 0, float32(pixel.X)) // X coordinate in image
-	imagePoint.SetFloatAt(0, 1, float32(pixel.Y)) // Y coordinate in image
+	imagePoint.SetFloatAt(0, 0, pixel.X) // X coordinate in image
+	imagePoint.SetFloatAt(0, 1, pixel.Y) // Y coordinate in image
 
 	// Perform perspective transformation using the homography matrix
 	transformedPoints := gocv.NewMat()
@@ -36,3 +42,86 @@ func (calibration *PoseCalibration) PixelTo3D(pixel image.Point, heightOffset fl
 		Z: 0,
 	}
 }
+
+// TableToPixel maps a point on the table to the undistorted pixel it is seen
+// at, the inverse of PixelTo3D at the table's surface
+func (calibration *PoseCalibration) TableToPixel(point gocv.Point2f) gocv.Point2f {
+	if calibration.HomographyMat.Empty() {
+		fmt.Println("Warning: Homography matrix is empty")
+		return gocv.Point2f{}
+	}
+
+	inverse := gocv.NewMat()
+	defer inverse.Close()
+	gocv.Invert(calibration.HomographyMat, &inverse, gocv.SolveDecompositionLu)
+
+	tablePoint := gocv.NewMatWithSize(1, 1, gocv.MatTypeCV32FC2)
+	defer tablePoint.Close()
+	tablePoint.SetFloatAt(0, 0, point.X)
+	tablePoint.SetFloatAt(0, 1, point.Y)
+
+	transformedPoints := gocv.NewMat()
+	defer transformedPoints.Close()
+	gocv.PerspectiveTransform(tablePoint, &transformedPoints, inverse)
+
+	return gocv.Point2f{
+		X: transformedPoints.GetFloatAt(0, 0),
+		Y: transformedPoints.GetFloatAt(0, 1),
+	}
+}
+
+// TableBounds returns the corners of the smallest rectangle on the table that
+// contains all the reference markers the homography was computed from. Markers
+// that InlierMask left out of it, e.g. because they were placed in the wrong
+// spot, are ignored.
+func (calibration *PoseCalibration) TableBounds() (min, max gocv.Point2f, ok bool) {
+	for i, corner := range calibration.RealCorners {
+		if i < len(calibration.InlierMask) && !calibration.InlierMask[i] {
+			continue
+		}
+		if !ok {
+			min = gocv.Point2f{X: corner.X, Y: corner.Y}
+			max = min
+			ok = true
+			continue
+		}
+
+		min.X = float32(math.Min(float64(min.X), float64(corner.X)))
+		min.Y = float32(math.Min(float64(min.Y), float64(corner.Y)))
+		max.X = float32(math.Max(float64(max.X), float64(corner.X)))
+		max.Y = float32(math.Max(float64(max.Y), float64(corner.Y)))
+	}
+	return min, max, ok
+}
+
+// MeasureReprojectionError returns the RMS distance, in table units, between
+// each real point and its undistorted image point mapped onto the table
+func (calibration *PoseCalibration) MeasureReprojectionError(imagePoints []gocv.Point2f, realPoints []gocv.Point3f) float64 {
+	residuals := calibration.MeasureResiduals(imagePoints, realPoints)
+	if len(residuals) == 0 {
+		return 0
+	}
+
+	total := 0.0
+	for _, residual := range residuals {
+		total += residual * residual
+	}
+	return math.Sqrt(total / float64(len(residuals)))
+}
+
+// MeasureResiduals returns the distance, in table units, between each real
+// point and its undistorted image point mapped onto the table
+func (calibration *PoseCalibration) MeasureResiduals(imagePoints []gocv.Point2f, realPoints []gocv.Point3f) []float64 {
+	if calibration.HomographyMat.Empty() {
+		return nil
+	}
+
+	residuals := make([]float64, len(imagePoints))
+	for i, pt := range imagePoints {
+		projected := calibration.PixelTo3D(pt, 0)
+		dx := float64(projected.X - realPoints[i].X)
+		dy := float64(projected.Y - realPoints[i].Y)
+		residuals[i] = math.Sqrt(dx*dx + dy*dy)
+	}
+	return residuals
+}
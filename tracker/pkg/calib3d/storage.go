@@ -0,0 +1,224 @@
+package calib3d
+
+import (
+	"encoding/json"
+	"fmt"
+	"image"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gocv.io/x/gocv"
+)
+
+// poseCalibrationVersion is bumped whenever the stored format changes. Version
+// 2 added the marker IDs, corner statistics, fit quality, extrinsics and
+// recalibration flag. Version 1 calibrations still load, without them.
+const poseCalibrationVersion = 2
+
+type storedPoseCalibration struct {
+	Version      int            `json:"version"`
+	CalibratedAt time.Time      `json:"calibratedAt"`
+	Resolution   storedSize     `json:"resolution"`
+	FoundCorners []int32        `json:"foundCorners"`
+	Locations    []gocv.Point2f `json:"cornerLocations"`
+	Deviations   []float64      `json:"cornerDeviations"`
+	MarkerIds    []int32        `json:"markerIds"`
+	RealCorners  []gocv.Point3f `json:"realCorners"`
+	Error        float64        `json:"reprojectionError"`
+	InlierMask   []bool         `json:"inlierMask"`
+	Residuals    []float64      `json:"residuals"`
+	Invalidated  bool           `json:"needsRecalibration,omitempty"`
+	Yaw          float64        `json:"yaw"`
+	Pitch        float64        `json:"pitch"`
+	Roll         float64        `json:"roll"`
+	Homography   [][]float64    `json:"homography"`
+	Rotation     [][]float64    `json:"rotation,omitempty"`
+	Translation  [][]float64    `json:"translation,omitempty"`
+	CameraMatrix [][]float64    `json:"cameraMatrix,omitempty"`
+}
+
+type storedSize struct {
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+func (p *PoseCalibration) MarshalJSON() ([]byte, error) {
+	mats := make([][][]float64, 0, 4)
+	for _, mat := range []gocv.Mat{p.HomographyMat, p.RotationMat, p.TranslationMat, p.CameraMatrix} {
+		rows, err := matToRows(mat)
+		if err != nil {
+			return nil, err
+		}
+		mats = append(mats, rows)
+	}
+
+	return json.Marshal(storedPoseCalibration{
+		Version:      poseCalibrationVersion,
+		CalibratedAt: p.CalibratedAt,
+		Resolution:   storedSize{Width: p.Resolution.X, Height: p.Resolution.Y},
+		FoundCorners: p.FoundCorners,
+		Locations:    p.CornerLocations,
+		Deviations:   p.CornerDeviations,
+		MarkerIds:    p.MarkerIds,
+		RealCorners:  p.RealCorners,
+		Error:        p.ReprojectionError,
+		InlierMask:   p.InlierMask,
+		Residuals:    p.Residuals,
+		Invalidated:  p.NeedsRecalibration,
+		Yaw:          p.Yaw,
+		Pitch:        p.Pitch,
+		Roll:         p.Roll,
+		Homography:   mats[0],
+		Rotation:     mats[1],
+		Translation:  mats[2],
+		CameraMatrix: mats[3],
+	})
+}
+
+func (p *PoseCalibration) UnmarshalJSON(data []byte) error {
+	stored := storedPoseCalibration{}
+	err := json.Unmarshal(data, &stored)
+	if err != nil {
+		return err
+	}
+
+	if stored.Version < 1 || stored.Version > poseCalibrationVersion {
+		return fmt.Errorf("unsupported pose calibration version %d", stored.Version)
+	}
+
+	// Version 1 always calibrated from the four corner markers, with IDs 1 to 4
+	// in the order of the real corners
+	if stored.Version == 1 && len(stored.MarkerIds) == 0 {
+		for i := range stored.RealCorners {
+			stored.MarkerIds = append(stored.MarkerIds, int32(i+1))
+		}
+	}
+
+	mats := make([]gocv.Mat, 0, 4)
+	for _, rows := range [][][]float64{stored.Homography, stored.Rotation, stored.Translation, stored.CameraMatrix} {
+		mat, err := rowsToMat(rows)
+		if err != nil {
+			for _, m := range mats {
+				m.Close()
+			}
+			return err
+		}
+		mats = append(mats, mat)
+	}
+
+	for _, m := range []gocv.Mat{p.HomographyMat, p.RotationMat, p.TranslationMat, p.CameraMatrix} {
+		if m.Ptr() != nil {
+			m.Close()
+		}
+	}
+	*p = PoseCalibration{
+		FoundCorners:       stored.FoundCorners,
+		CornerLocations:    stored.Locations,
+		CornerDeviations:   stored.Deviations,
+		MarkerIds:          stored.MarkerIds,
+		RealCorners:        stored.RealCorners,
+		ReprojectionError:  stored.Error,
+		InlierMask:         stored.InlierMask,
+		Residuals:          stored.Residuals,
+		NeedsRecalibration: stored.Invalidated,
+		Resolution:         image.Pt(stored.Resolution.Width, stored.Resolution.Height),
+		CalibratedAt:       stored.CalibratedAt,
+		Yaw:                stored.Yaw,
+		Pitch:              stored.Pitch,
+		Roll:               stored.Roll,
+		HomographyMat:      mats[0],
+		RotationMat:        mats[1],
+		TranslationMat:     mats[2],
+		CameraMatrix:       mats[3],
+	}
+	return nil
+}
+
+// Save writes the calibration to path, replacing any previous calibration
+func (p *PoseCalibration) Save(path string) error {
+	return writeJSON(path, p)
+}
+
+func writeJSON(path string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so a crash mid-write doesn't leave a
+	// corrupt calibration behind
+	tmp := path + ".tmp"
+	err = os.WriteFile(tmp, data, 0644)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// LoadPoseCalibration reads a calibration written by Save. The calibration is
+// rejected if it was made at a different camera resolution, as the homography
+// maps from pixel coordinates.
+func LoadPoseCalibration(path string, resolution image.Point) (*PoseCalibration, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	p := NewPoseCalibration()
+	err = json.Unmarshal(data, p)
+	if err != nil {
+		p.Close()
+		return nil, err
+	}
+
+	if p.Resolution != resolution {
+		p.Close()
+		return nil, fmt.Errorf("calibration was made at %v, camera is running at %v", p.Resolution, resolution)
+	}
+
+	return p, nil
+}
+
+// matToRows copies a single channel CV64F matrix, as used for calibrations,
+// into rows of values
+func matToRows(mat gocv.Mat) ([][]float64, error) {
+	if mat.Empty() {
+		return nil, nil
+	}
+	if mat.Type() != gocv.MatTypeCV64F {
+		return nil, fmt.Errorf("matrix has type %v, expected %v", mat.Type(), gocv.MatTypeCV64F)
+	}
+
+	rows := make([][]float64, mat.Rows())
+	for r := range rows {
+		rows[r] = make([]float64, mat.Cols())
+		for c := range rows[r] {
+			rows[r][c] = mat.GetDoubleAt(r, c)
+		}
+	}
+	return rows, nil
+}
+
+func rowsToMat(rows [][]float64) (gocv.Mat, error) {
+	if len(rows) == 0 {
+		return gocv.NewMat(), nil
+	}
+
+	mat := gocv.NewMatWithSize(len(rows), len(rows[0]), gocv.MatTypeCV64F)
+	for r, row := range rows {
+		if len(row) != mat.Cols() {
+			mat.Close()
+			return gocv.NewMat(), fmt.Errorf("matrix row %d has %d columns, expected %d", r, len(row), mat.Cols())
+		}
+		for c, value := range row {
+			mat.SetDoubleAt(r, c, value)
+		}
+	}
+	return mat, nil
+}
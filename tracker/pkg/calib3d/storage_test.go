@@ -0,0 +1,190 @@
+package calib3d
+
+import (
+	"image"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"gocv.io/x/gocv"
+)
+
+func mustRowsToMat(t *testing.T, rows [][]float64) gocv.Mat {
+	t.Helper()
+
+	mat, err := rowsToMat(rows)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return mat
+}
+
+func mustMatToRows(t *testing.T, mat gocv.Mat) [][]float64 {
+	t.Helper()
+
+	rows, err := matToRows(mat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rows
+}
+
+func TestPoseCalibrationRoundTrip(t *testing.T) {
+	homography := [][]float64{{1.5, 0.1, -20}, {0.05, 1.4, -10}, {0.0001, 0.0002, 1}}
+	rotation := [][]float64{{1, 0, 0}, {0, -1, 0}, {0, 0, -1}}
+	translation := [][]float64{{-12}, {8}, {40}}
+	cameraMatrix := [][]float64{{900, 0, 640}, {0, 900, 360}, {0, 0, 1}}
+
+	p := &PoseCalibration{
+		FoundCorners:       []int32{1, 2, 3, 4, 7},
+		CornerLocations:    []gocv.Point2f{{X: 10, Y: 20}, {X: 1200, Y: 25}, {X: 1190, Y: 700}, {X: 15, Y: 690}, {X: 600, Y: 350}},
+		CornerDeviations:   []float64{0.1, 0.2, 0.15, 0.3, 0.05},
+		MarkerIds:          []int32{1, 2, 3, 4, 7},
+		RealCorners:        []gocv.Point3f{{X: 0, Y: 0}, {X: 24, Y: 0}, {X: 24, Y: 16}, {X: 0, Y: 16}, {X: 12, Y: 8}},
+		ReprojectionError:  0.04,
+		InlierMask:         []bool{true, true, true, true, false},
+		Residuals:          []float64{0.02, 0.03, 0.05, 0.04, 1.2},
+		Resolution:         image.Pt(1280, 720),
+		CalibratedAt:       time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC),
+		Yaw:                1.5,
+		Pitch:              -2,
+		Roll:               180,
+		HomographyMat:      mustRowsToMat(t, homography),
+		RotationMat:        mustRowsToMat(t, rotation),
+		TranslationMat:     mustRowsToMat(t, translation),
+		CameraMatrix:       mustRowsToMat(t, cameraMatrix),
+		NeedsRecalibration: true,
+	}
+	defer p.Close()
+
+	path := filepath.Join(t.TempDir(), "calibration", "pose.json")
+	err := p.Save(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := LoadPoseCalibration(path, p.Resolution)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer loaded.Close()
+
+	for name, mats := range map[string][2]gocv.Mat{
+		"homography":    {loaded.HomographyMat, p.HomographyMat},
+		"rotation":      {loaded.RotationMat, p.RotationMat},
+		"translation":   {loaded.TranslationMat, p.TranslationMat},
+		"camera matrix": {loaded.CameraMatrix, p.CameraMatrix},
+	} {
+		got, expected := mustMatToRows(t, mats[0]), mustMatToRows(t, mats[1])
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("%s is %v, expected %v", name, got, expected)
+		}
+	}
+
+	// Everything else should match exactly once the mats are set aside
+	got, expected := *loaded, *p
+	for _, c := range []*PoseCalibration{&got, &expected} {
+		c.HomographyMat = gocv.Mat{}
+		c.RotationMat = gocv.Mat{}
+		c.TranslationMat = gocv.Mat{}
+		c.CameraMatrix = gocv.Mat{}
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("loaded %+v, expected %+v", got, expected)
+	}
+
+	_, err = LoadPoseCalibration(path, image.Pt(640, 480))
+	if err == nil {
+		t.Error("expected a calibration made at another resolution to be rejected")
+	}
+}
+
+func TestLoadPoseCalibrationVersions(t *testing.T) {
+	tests := []struct {
+		name     string
+		stored   string
+		valid    bool
+		expected []int32
+	}{
+		{
+			name: "version 1",
+			stored: `{
+				"version": 1,
+				"calibratedAt": "2024-01-01T00:00:00Z",
+				"resolution": {"width": 1280, "height": 720},
+				"foundCorners": [3, 1, 4, 2],
+				"realCorners": [{"X": 0, "Y": 0, "Z": 0}, {"X": 24, "Y": 0, "Z": 0}, {"X": 24, "Y": 16, "Z": 0}, {"X": 0, "Y": 16, "Z": 0}],
+				"yaw": 0,
+				"pitch": 0,
+				"roll": 0,
+				"homography": [[1, 0, 0], [0, 1, 0], [0, 0, 1]]
+			}`,
+			valid:    true,
+			expected: []int32{1, 2, 3, 4},
+		},
+		{
+			name:   "unknown version",
+			stored: `{"version": 99, "resolution": {"width": 1280, "height": 720}}`,
+		},
+		{
+			name:   "missing version",
+			stored: `{"resolution": {"width": 1280, "height": 720}}`,
+		},
+		{
+			name:   "ragged homography",
+			stored: `{"version": 2, "resolution": {"width": 1280, "height": 720}, "homography": [[1, 0, 0], [0, 1]]}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "pose.json")
+			err := os.WriteFile(path, []byte(test.stored), 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			p, err := LoadPoseCalibration(path, image.Pt(1280, 720))
+			if !test.valid {
+				if err == nil {
+					p.Close()
+					t.Fatal("expected the calibration to be rejected")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer p.Close()
+
+			if p.HomographyMat.Empty() || p.HasExtrinsics() || p.NeedsRecalibration {
+				t.Errorf("loaded %+v", p)
+			}
+			if !reflect.DeepEqual(p.MarkerIds, test.expected) {
+				t.Errorf("marker IDs are %v, expected %v", p.MarkerIds, test.expected)
+			}
+		})
+	}
+}
+
+func TestMatToRowsType(t *testing.T) {
+	mat := gocv.NewMatWithSize(3, 3, gocv.MatTypeCV32F)
+	defer mat.Close()
+
+	_, err := matToRows(mat)
+	if err == nil {
+		t.Error("expected a CV32F matrix to be rejected")
+	}
+
+	p := NewPoseCalibration()
+	defer p.Close()
+	p.HomographyMat.Close()
+	p.HomographyMat = mat.Clone()
+
+	err = p.Save(filepath.Join(t.TempDir(), "pose.json"))
+	if err == nil {
+		t.Error("expected a calibration with a CV32F homography not to be saved")
+	}
+}
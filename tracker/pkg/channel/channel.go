@@ -0,0 +1,31 @@
+package channel
+
+import (
+	"context"
+
+	"github.com/tutman96/fantassist.io/tracker/protos"
+)
+
+type RequestHandler func(req *protos.Request) *protos.Response
+
+// Channel is a transport that carries protos.Packet messages between the
+// tracker and its clients
+type Channel interface {
+	// Start begins accepting connections until the context is cancelled
+	Start(ctx context.Context) error
+
+	// OnConnectionStateChange returns a channel that receives the new state
+	// whenever a client connects or disconnects
+	OnConnectionStateChange() <-chan bool
+
+	// AddRequestHandler registers a handler for incoming requests. Handlers
+	// are called in order until one returns a non-nil response.
+	AddRequestHandler(handler RequestHandler)
+
+	// Request sends a request to the client and blocks until it responds
+	Request(req *protos.Request) *protos.Response
+
+	// SendPacket sends a packet to the client. Packets without a request ID
+	// are broadcasts.
+	SendPacket(packet *protos.Packet)
+}
@@ -0,0 +1,37 @@
+package channel
+
+import "sync"
+
+// ConnectionListeners holds the channels handed out by OnConnectionStateChange.
+// Each channel buffers the latest state, so notifying never blocks on a
+// listener that has stopped receiving, e.g. because its context was cancelled.
+// The zero value is ready to use.
+type ConnectionListeners struct {
+	mutex     sync.Mutex
+	listeners []chan bool
+}
+
+// Add returns a channel that receives the state passed to every later Notify
+func (l *ConnectionListeners) Add() <-chan bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	c := make(chan bool, 1)
+	l.listeners = append(l.listeners, c)
+	return c
+}
+
+// Notify sends the state to every listener, replacing any state a listener
+// hasn't received yet
+func (l *ConnectionListeners) Notify(connected bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	for _, c := range l.listeners {
+		select {
+		case <-c:
+		default:
+		}
+		c <- connected
+	}
+}
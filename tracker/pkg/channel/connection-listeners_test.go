@@ -0,0 +1,22 @@
+package channel
+
+import "testing"
+
+func TestConnectionListenersKeepLatestState(t *testing.T) {
+	var listeners ConnectionListeners
+	c := listeners.Add()
+
+	// Nothing is receiving, so these mustn't block
+	listeners.Notify(true)
+	listeners.Notify(false)
+	listeners.Notify(true)
+
+	if connected := <-c; !connected {
+		t.Errorf("expected the latest state to be connected")
+	}
+	select {
+	case connected := <-c:
+		t.Errorf("expected a single buffered state, also got %v", connected)
+	default:
+	}
+}
@@ -0,0 +1,101 @@
+package channel
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/tutman96/fantassist.io/tracker/protos"
+)
+
+// Dispatcher keeps track of request handlers and outstanding requests so that
+// each Channel implementation only has to move packets
+type Dispatcher struct {
+	// RespondToBroadcasts makes HandlePacket respond to requests without a
+	// request ID too, for transports whose clients expect a response to every
+	// request
+	RespondToBroadcasts bool
+
+	mutex           sync.Mutex
+	requestHandlers []RequestHandler
+	requestChannels map[string]chan *protos.Response
+}
+
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{
+		requestHandlers: make([]RequestHandler, 0),
+		requestChannels: make(map[string]chan *protos.Response),
+	}
+}
+
+func (d *Dispatcher) AddRequestHandler(handler RequestHandler) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.requestHandlers = append(d.requestHandlers, handler)
+}
+
+// NewRequest wraps the request in a packet with a new request ID. The returned
+// channel receives the response once it is passed to HandlePacket.
+func (d *Dispatcher) NewRequest(req *protos.Request) (*protos.Packet, <-chan *protos.Response) {
+	id := uuid.New()
+	packet := &protos.Packet{
+		RequestId: id.String(),
+		Message: &protos.Packet_Request{
+			Request: req,
+		},
+	}
+
+	response := make(chan *protos.Response, 1)
+
+	d.mutex.Lock()
+	d.requestChannels[id.String()] = response
+	d.mutex.Unlock()
+
+	return packet, response
+}
+
+// HandlePacket processes an incoming packet. Requests are passed to the
+// registered handlers and the resulting response packet is returned so the
+// caller can send it back over the same transport. Broadcasts (requests
+// without a request ID) aren't responded to unless RespondToBroadcasts is set.
+// Responses are delivered to the matching NewRequest caller.
+func (d *Dispatcher) HandlePacket(packet *protos.Packet) *protos.Packet {
+	switch packet.Message.(type) {
+	case *protos.Packet_Request:
+		req := packet.GetRequest()
+
+		d.mutex.Lock()
+		handlers := d.requestHandlers
+		d.mutex.Unlock()
+
+		for _, handler := range handlers {
+			response := handler(req)
+			if response != nil {
+				if packet.RequestId == "" && !d.RespondToBroadcasts {
+					return nil
+				}
+				return &protos.Packet{
+					RequestId: packet.RequestId,
+					Message: &protos.Packet_Response{
+						Response: response,
+					},
+				}
+			}
+		}
+		fmt.Println("No handler found for request", req)
+	case *protos.Packet_Response:
+		d.mutex.Lock()
+		c, ok := d.requestChannels[packet.RequestId]
+		delete(d.requestChannels, packet.RequestId)
+		d.mutex.Unlock()
+
+		if ok {
+			c <- packet.GetResponse()
+		} else {
+			fmt.Println("No channel found for request ID", packet.RequestId)
+		}
+	}
+
+	return nil
+}
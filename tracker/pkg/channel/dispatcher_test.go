@@ -0,0 +1,31 @@
+package channel
+
+import (
+	"testing"
+
+	"github.com/tutman96/fantassist.io/tracker/protos"
+)
+
+func TestDispatcherBroadcasts(t *testing.T) {
+	broadcast := &protos.Packet{
+		Message: &protos.Packet_Request{
+			Request: &protos.Request{
+				Message: &protos.Request_HelloRequest{},
+			},
+		},
+	}
+
+	d := NewDispatcher()
+	d.AddRequestHandler(func(req *protos.Request) *protos.Response {
+		return &protos.Response{Message: &protos.Response_AckResponse{}}
+	})
+
+	if response := d.HandlePacket(broadcast); response != nil {
+		t.Errorf("expected no response to a broadcast, got %v", response)
+	}
+
+	d.RespondToBroadcasts = true
+	if response := d.HandlePacket(broadcast); response.GetResponse() == nil {
+		t.Errorf("expected a response to the broadcast")
+	}
+}
@@ -0,0 +1,112 @@
+package channel
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/tutman96/fantassist.io/tracker/protos"
+	"google.golang.org/protobuf/proto"
+)
+
+// LoopbackChannel is one end of an in-memory pair of channels. Packets sent on
+// one end are received by the other, which makes it possible to drive the
+// tracker without any Bluetooth or network hardware.
+type LoopbackChannel struct {
+	peer       *LoopbackChannel
+	dispatcher *Dispatcher
+	inbound    chan []byte
+
+	mutex               sync.Mutex
+	connected           bool
+	connectionListeners ConnectionListeners
+}
+
+// NewLoopbackPair returns two channels that are connected to each other. Both
+// ends must be started before packets are delivered.
+func NewLoopbackPair() (*LoopbackChannel, *LoopbackChannel) {
+	a := newLoopbackChannel()
+	b := newLoopbackChannel()
+	a.peer = b
+	b.peer = a
+	return a, b
+}
+
+func newLoopbackChannel() *LoopbackChannel {
+	return &LoopbackChannel{
+		dispatcher: NewDispatcher(),
+		inbound:    make(chan []byte, 64),
+	}
+}
+
+func (l *LoopbackChannel) Start(ctx context.Context) error {
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				l.setConnected(false)
+				return
+			case bytes := <-l.inbound:
+				l.receive(bytes)
+			}
+		}
+	}()
+
+	l.setConnected(true)
+	return nil
+}
+
+func (l *LoopbackChannel) setConnected(connected bool) {
+	l.mutex.Lock()
+	changed := l.connected != connected
+	l.connected = connected
+	l.mutex.Unlock()
+
+	if changed {
+		l.connectionListeners.Notify(connected)
+	}
+}
+
+func (l *LoopbackChannel) OnConnectionStateChange() <-chan bool {
+	return l.connectionListeners.Add()
+}
+
+func (l *LoopbackChannel) AddRequestHandler(handler RequestHandler) {
+	l.dispatcher.AddRequestHandler(handler)
+}
+
+func (l *LoopbackChannel) Request(req *protos.Request) *protos.Response {
+	packet, response := l.dispatcher.NewRequest(req)
+	l.SendPacket(packet)
+	return <-response
+}
+
+// SendPacket marshals the packet, as a real transport would, and queues it for
+// the other end of the pair
+func (l *LoopbackChannel) SendPacket(packet *protos.Packet) {
+	bytes, err := proto.Marshal(packet)
+	if err != nil {
+		fmt.Println("Error marshalling packet:", err)
+		return
+	}
+
+	l.peer.inbound <- bytes
+}
+
+func (l *LoopbackChannel) receive(bytes []byte) {
+	packet := &protos.Packet{}
+	err := proto.Unmarshal(bytes, packet)
+	if err != nil {
+		fmt.Println("Error unmarshalling packet:", err)
+		return
+	}
+
+	// Handle each packet on its own goroutine so a handler that makes a
+	// request of its own doesn't block delivery of the response
+	go func() {
+		response := l.dispatcher.HandlePacket(packet)
+		if response != nil {
+			l.SendPacket(response)
+		}
+	}()
+}
@@ -0,0 +1,55 @@
+package channel
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tutman96/fantassist.io/tracker/protos"
+)
+
+func TestLoopbackRequest(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tracker, app := NewLoopbackPair()
+	tracker.AddRequestHandler(func(req *protos.Request) *protos.Response {
+		if _, ok := req.Message.(*protos.Request_TrackerGetStatusRequest); !ok {
+			return nil
+		}
+		return &protos.Response{
+			Message: &protos.Response_TrackerGetStatusResponse{
+				TrackerGetStatusResponse: &protos.TrackerGetStatusResponse{Version: "test"},
+			},
+		}
+	})
+	tracker.Start(ctx)
+	app.Start(ctx)
+
+	response := app.Request(&protos.Request{
+		Message: &protos.Request_TrackerGetStatusRequest{
+			TrackerGetStatusRequest: &protos.TrackerGetStatusRequest{},
+		},
+	})
+	if version := response.GetTrackerGetStatusResponse().GetVersion(); version != "test" {
+		t.Errorf("expected the status response, got %v", response)
+	}
+}
+
+func TestLoopbackDisconnect(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	l, _ := NewLoopbackPair()
+	l.Start(ctx)
+	connectionChange := l.OnConnectionStateChange()
+	cancel()
+
+	select {
+	case connected := <-connectionChange:
+		if connected {
+			t.Error("expected a disconnect once the context was cancelled")
+		}
+	case <-time.After(time.Second):
+		t.Error("listener wasn't notified of the disconnect")
+	}
+}
@@ -0,0 +1,112 @@
+package channel
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/tutman96/fantassist.io/tracker/protos"
+)
+
+// MultiChannel combines several channels into one. Packets are sent to every
+// channel that currently has a client connected, and it is considered connected
+// as long as any of its channels are.
+type MultiChannel struct {
+	channels []Channel
+
+	mutex               sync.Mutex
+	connected           []bool
+	connectionListeners ConnectionListeners
+}
+
+func NewMultiChannel(channels ...Channel) *MultiChannel {
+	return &MultiChannel{
+		channels:  channels,
+		connected: make([]bool, len(channels)),
+	}
+}
+
+func (m *MultiChannel) Start(ctx context.Context) error {
+	for i, c := range m.channels {
+		// Subscribe before starting so no state change is missed
+		connectionChange := c.OnConnectionStateChange()
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case connected := <-connectionChange:
+					m.setConnected(i, connected)
+				}
+			}
+		}()
+
+		err := c.Start(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *MultiChannel) setConnected(index int, connected bool) {
+	m.mutex.Lock()
+	wasConnected := m.anyConnected()
+	m.connected[index] = connected
+	isConnected := m.anyConnected()
+	m.mutex.Unlock()
+
+	if wasConnected != isConnected {
+		m.connectionListeners.Notify(isConnected)
+	}
+}
+
+func (m *MultiChannel) anyConnected() bool {
+	for _, connected := range m.connected {
+		if connected {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *MultiChannel) connectedChannels() []Channel {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	channels := make([]Channel, 0, len(m.channels))
+	for i, c := range m.channels {
+		if m.connected[i] {
+			channels = append(channels, c)
+		}
+	}
+	return channels
+}
+
+func (m *MultiChannel) OnConnectionStateChange() <-chan bool {
+	return m.connectionListeners.Add()
+}
+
+func (m *MultiChannel) AddRequestHandler(handler RequestHandler) {
+	for _, c := range m.channels {
+		c.AddRequestHandler(handler)
+	}
+}
+
+// Request sends the request over the first connected channel
+func (m *MultiChannel) Request(req *protos.Request) *protos.Response {
+	channels := m.connectedChannels()
+	if len(channels) == 0 {
+		fmt.Println("No connected channel to send request over", req)
+		return nil
+	}
+
+	return channels[0].Request(req)
+}
+
+func (m *MultiChannel) SendPacket(packet *protos.Packet) {
+	for _, c := range m.connectedChannels() {
+		c.SendPacket(packet)
+	}
+}
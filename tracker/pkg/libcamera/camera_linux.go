@@ -26,7 +26,7 @@ import (
 )
 
 type Camera struct {
-	frames     chan Frame
+	frames     chan gocv.Mat
 	bufs       chan interface{}
 	destroyed  chan struct{}
 	closed     chan struct{}
@@ -34,14 +34,6 @@ type Camera struct {
 	handle     cgo.Handle
 }
 
-type Frame struct {
-	image gocv.Mat
-}
-
-func (f Frame) Image() gocv.Mat {
-	return f.image
-}
-
 func (c *Camera) Stop() {
 	close(c.closed)
 	for {
@@ -67,9 +59,9 @@ func requestCallback(handle C.uintptr_t) {
 	}
 }
 
-func Open(resolution image.Point, frames chan Frame) (*Camera, error) {
+func Open(resolution image.Point) (*Camera, error) {
 	c := &Camera{
-		frames:     frames,
+		frames:     make(chan gocv.Mat),
 		resolution: resolution,
 		destroyed:  make(chan struct{}),
 		closed:     make(chan struct{}),
@@ -89,6 +81,12 @@ func Open(resolution image.Point, frames chan Frame) (*Camera, error) {
 	return c, nil
 }
 
+// Frames returns the channel that receives each captured frame. The same Mat
+// is reused for every frame. It is closed once the camera stops.
+func (c *Camera) Frames() <-chan gocv.Mat {
+	return c.frames
+}
+
 func (c *Camera) SetExposure(microseconds int) {
 	// TODO: error handling when camera not started
 	C.set_exposure(C.uint(microseconds))
@@ -99,6 +97,7 @@ func (c *Camera) GetExposure() int {
 }
 
 func (c *Camera) Start() error {
+	defer close(c.frames)
 	defer close(c.destroyed)
 	defer c.handle.Delete()
 	defer C.close_camera()
@@ -119,15 +118,12 @@ func (c *Camera) Start() error {
 		return err
 	}
 
-	f := Frame{
-		image: mat,
-	}
 	for {
 		select {
 		case <-c.closed:
 			return nil
 		case <-c.bufs:
-			c.frames <- f
+			c.frames <- mat
 			if res := C.queue_request(); res != 0 {
 				return fmt.Errorf("queue_request: %d", res)
 			}
@@ -0,0 +1,124 @@
+package pkg
+
+import (
+	"math"
+	"sync"
+
+	"github.com/tutman96/fantassist.io/tracker/protos"
+)
+
+const (
+	defaultDeltaTolerance   = 0.1
+	defaultKeyframeInterval = 20
+
+	// How far, in radians, a marker has to turn to be sent in a delta update
+	deltaHeadingTolerance = 0.1
+)
+
+// markerUpdateEncoder builds the periodic marker updates. In delta mode only
+// markers that changed since they were last sent are included, with a full
+// keyframe every so often or whenever a client asks to resync.
+type markerUpdateEncoder struct {
+	delta            bool
+	tolerance        float64
+	keyframeInterval int
+
+	mutex         sync.Mutex
+	sequence      uint32
+	sinceKeyframe int
+	resync        bool
+
+	// What the clients were last told about each marker
+	sent map[int32]*protos.TrackerMarker
+}
+
+func newMarkerUpdateEncoder(req *protos.TrackerStartTrackingRequest) *markerUpdateEncoder {
+	e := &markerUpdateEncoder{
+		delta:            req.DeltaUpdates,
+		tolerance:        float64(req.DeltaTolerance),
+		keyframeInterval: int(req.KeyframeInterval),
+	}
+	if e.tolerance <= 0 {
+		e.tolerance = defaultDeltaTolerance
+	}
+	if e.keyframeInterval <= 0 {
+		e.keyframeInterval = defaultKeyframeInterval
+	}
+	return e
+}
+
+// requestResync makes the next update a keyframe
+func (e *markerUpdateEncoder) requestResync() {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	e.resync = true
+}
+
+// encode returns the update to send for the current markers, or nil if nothing
+// has changed since the last update
+func (e *markerUpdateEncoder) encode(markers map[int32]*protos.TrackerMarker) *protos.TrackerUpdateMarkerLocationRequest {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	e.sinceKeyframe++
+	keyframe := !e.delta || e.resync || e.sent == nil || e.sinceKeyframe >= e.keyframeInterval
+
+	changed := markers
+	removed := []int32{}
+	if keyframe {
+		e.sent = make(map[int32]*protos.TrackerMarker, len(markers))
+		for key, marker := range markers {
+			e.sent[key] = marker
+		}
+		e.sinceKeyframe = 0
+		e.resync = false
+	} else {
+		changed = make(map[int32]*protos.TrackerMarker)
+		for key, marker := range markers {
+			if e.hasChanged(e.sent[key], marker) {
+				changed[key] = marker
+				e.sent[key] = marker
+			}
+		}
+		for key := range e.sent {
+			if _, ok := markers[key]; !ok {
+				removed = append(removed, key)
+				delete(e.sent, key)
+			}
+		}
+
+		if len(changed) == 0 && len(removed) == 0 {
+			return nil
+		}
+	}
+
+	e.sequence++
+	return &protos.TrackerUpdateMarkerLocationRequest{
+		MarkerLocations: markerLocations(changed),
+		MarkerColors:    markerColors(changed),
+		Markers:         changed,
+		Sequence:        e.sequence,
+		Keyframe:        keyframe,
+		RemovedKeys:     removed,
+	}
+}
+
+func (e *markerUpdateEncoder) hasChanged(sent, marker *protos.TrackerMarker) bool {
+	if sent == nil {
+		return true
+	}
+	if sent.Lost != marker.Lost || sent.Color != marker.Color || sent.PairedKey != marker.PairedKey {
+		return true
+	}
+	if sent.HasHeading != marker.HasHeading || sent.HeadingSource != marker.HeadingSource {
+		return true
+	}
+	if math.Abs(math.Remainder(float64(sent.Heading-marker.Heading), 2*math.Pi)) > deltaHeadingTolerance {
+		return true
+	}
+
+	dx := float64(sent.Location.X - marker.Location.X)
+	dy := float64(sent.Location.Y - marker.Location.Y)
+	return math.Sqrt(dx*dx+dy*dy) > e.tolerance
+}
@@ -0,0 +1,236 @@
+package pkg
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/tutman96/fantassist.io/tracker/protos"
+)
+
+func testMarker(x, y float32) *protos.TrackerMarker {
+	return &protos.TrackerMarker{
+		Location: &protos.TrackerVector2D{X: x, Y: y},
+		Color:    protos.TrackerMarkerColor_COLOR_RED,
+	}
+}
+
+// markerUpdateDecoder applies updates the way the app does, checking that
+// they arrive in sequence
+type markerUpdateDecoder struct {
+	t        *testing.T
+	sequence uint32
+	markers  map[int32]*protos.TrackerMarker
+}
+
+func (d *markerUpdateDecoder) apply(update *protos.TrackerUpdateMarkerLocationRequest) {
+	d.t.Helper()
+
+	if update.Sequence != d.sequence+1 {
+		d.t.Errorf("got sequence %d after %d", update.Sequence, d.sequence)
+	}
+	d.sequence = update.Sequence
+
+	if update.Keyframe {
+		d.markers = make(map[int32]*protos.TrackerMarker)
+	} else if d.markers == nil {
+		d.t.Fatal("got a delta update before any keyframe")
+	}
+	for key, marker := range update.Markers {
+		d.markers[key] = marker
+	}
+	for _, key := range update.RemovedKeys {
+		if _, ok := d.markers[key]; !ok {
+			d.t.Errorf("removed marker %d that was never sent", key)
+		}
+		delete(d.markers, key)
+	}
+}
+
+// check verifies that the decoded markers match the tracker's to within the
+// encoder's tolerance
+func (d *markerUpdateDecoder) check(markers map[int32]*protos.TrackerMarker, tolerance float64) {
+	d.t.Helper()
+
+	if len(d.markers) != len(markers) {
+		d.t.Errorf("decoded %d markers, expected %d", len(d.markers), len(markers))
+	}
+	for key, marker := range markers {
+		decoded, ok := d.markers[key]
+		if !ok {
+			d.t.Errorf("marker %d is missing", key)
+			continue
+		}
+		dx := float64(decoded.Location.X - marker.Location.X)
+		dy := float64(decoded.Location.Y - marker.Location.Y)
+		if math.Sqrt(dx*dx+dy*dy) > tolerance {
+			d.t.Errorf("marker %d is at %v, expected %v", key, decoded.Location, marker.Location)
+		}
+		if decoded.Lost != marker.Lost || decoded.Color != marker.Color {
+			d.t.Errorf("marker %d is %v, expected %v", key, decoded, marker)
+		}
+	}
+}
+
+func TestMarkerUpdatesKeyframesOnly(t *testing.T) {
+	e := newMarkerUpdateEncoder(&protos.TrackerStartTrackingRequest{})
+	markers := map[int32]*protos.TrackerMarker{1: testMarker(0, 0)}
+
+	for i := 0; i < 3; i++ {
+		update := e.encode(markers)
+		if update == nil {
+			t.Fatal("expected an update every frame without delta updates")
+		}
+		if !update.Keyframe || len(update.Markers) != 1 || update.Sequence != uint32(i+1) {
+			t.Errorf("update %d is %v", i, update)
+		}
+	}
+}
+
+func TestMarkerUpdatesDeltas(t *testing.T) {
+	e := newMarkerUpdateEncoder(&protos.TrackerStartTrackingRequest{DeltaUpdates: true, DeltaTolerance: 1, KeyframeInterval: 100})
+	d := markerUpdateDecoder{t: t}
+
+	markers := map[int32]*protos.TrackerMarker{
+		1: testMarker(0, 0),
+		2: testMarker(10, 10),
+	}
+	update := e.encode(markers)
+	if !update.Keyframe || len(update.Markers) != 2 {
+		t.Fatalf("expected the first update to be a keyframe of every marker, got %v", update)
+	}
+	d.apply(update)
+
+	// Nothing has moved further than the tolerance, and skipped updates don't
+	// use up a sequence number
+	markers = map[int32]*protos.TrackerMarker{
+		1: testMarker(0.5, 0),
+		2: testMarker(10, 10),
+	}
+	if update := e.encode(markers); update != nil {
+		t.Errorf("expected no update, got %v", update)
+	}
+
+	// Movement is measured from where the marker was last sent
+	markers[1] = testMarker(1.5, 0)
+	update = e.encode(markers)
+	if update == nil || update.Keyframe || len(update.Markers) != 1 || update.Markers[1] == nil {
+		t.Fatalf("expected a delta of marker 1, got %v", update)
+	}
+	d.apply(update)
+	d.check(markers, 1)
+
+	// Changes other than movement are always sent
+	markers[2] = testMarker(10, 10)
+	markers[2].Lost = true
+	update = e.encode(markers)
+	if update == nil || len(update.Markers) != 1 || !update.Markers[2].GetLost() {
+		t.Fatalf("expected a delta of marker 2, got %v", update)
+	}
+	d.apply(update)
+
+	// Removed and added markers
+	delete(markers, 1)
+	markers[3] = testMarker(20, 20)
+	update = e.encode(markers)
+	if update == nil || len(update.RemovedKeys) != 1 || update.RemovedKeys[0] != 1 || update.Markers[3] == nil {
+		t.Fatalf("expected marker 1 removed and marker 3 added, got %v", update)
+	}
+	d.apply(update)
+	d.check(markers, 1)
+
+	// Removals are only sent once
+	if update := e.encode(markers); update != nil {
+		t.Errorf("expected no update, got %v", update)
+	}
+}
+
+func TestMarkerUpdatesKeyframeInterval(t *testing.T) {
+	e := newMarkerUpdateEncoder(&protos.TrackerStartTrackingRequest{DeltaUpdates: true, DeltaTolerance: 1, KeyframeInterval: 5})
+	markers := map[int32]*protos.TrackerMarker{1: testMarker(0, 0)}
+
+	for frame := 0; frame < 16; frame++ {
+		update := e.encode(markers)
+		keyframe := frame%5 == 0
+		if keyframe {
+			if update == nil || !update.Keyframe || len(update.Markers) != 1 {
+				t.Errorf("expected a keyframe on frame %d, got %v", frame, update)
+			}
+		} else if update != nil {
+			t.Errorf("expected no update on frame %d, got %v", frame, update)
+		}
+	}
+}
+
+func TestMarkerUpdatesResync(t *testing.T) {
+	e := newMarkerUpdateEncoder(&protos.TrackerStartTrackingRequest{DeltaUpdates: true, DeltaTolerance: 1, KeyframeInterval: 100})
+	markers := map[int32]*protos.TrackerMarker{
+		1: testMarker(0, 0),
+		2: testMarker(10, 10),
+	}
+	e.encode(markers)
+	if update := e.encode(markers); update != nil {
+		t.Fatalf("expected no update, got %v", update)
+	}
+
+	// A client that missed updates asks to resync, and gets every marker even
+	// though nothing has changed
+	e.requestResync()
+	update := e.encode(markers)
+	if update == nil || !update.Keyframe || len(update.Markers) != 2 || update.Sequence != 2 {
+		t.Fatalf("expected a keyframe of every marker, got %v", update)
+	}
+
+	d := markerUpdateDecoder{t: t, sequence: update.Sequence - 1}
+	d.apply(update)
+	d.check(markers, 0)
+
+	// Then back to deltas
+	if update := e.encode(markers); update != nil {
+		t.Errorf("expected no update after the resync, got %v", update)
+	}
+}
+
+// TestMarkerUpdatesTrack applies the updates for markers that wander about,
+// appear and disappear, and checks the app always ends up with what the
+// tracker has
+func TestMarkerUpdatesTrack(t *testing.T) {
+	const tolerance = 0.5
+	e := newMarkerUpdateEncoder(&protos.TrackerStartTrackingRequest{DeltaUpdates: true, DeltaTolerance: tolerance, KeyframeInterval: 7})
+	d := markerUpdateDecoder{t: t}
+
+	random := rand.New(rand.NewSource(1))
+	markers := map[int32]*protos.TrackerMarker{}
+	nextKey := int32(1)
+	keyframes := 0
+	for frame := 0; frame < 200; frame++ {
+		next := make(map[int32]*protos.TrackerMarker, len(markers))
+		for key, marker := range markers {
+			if random.Float64() < 0.02 {
+				continue
+			}
+			moved := testMarker(marker.Location.X+float32(random.NormFloat64()*0.3), marker.Location.Y+float32(random.NormFloat64()*0.3))
+			moved.Lost = marker.Lost != (random.Float64() < 0.05)
+			next[key] = moved
+		}
+		if len(next) < 8 && random.Float64() < 0.1 {
+			next[nextKey] = testMarker(float32(random.Float64()*100), float32(random.Float64()*100))
+			nextKey++
+		}
+		markers = next
+
+		update := e.encode(markers)
+		if update == nil {
+			continue
+		}
+		if update.Keyframe {
+			keyframes++
+		}
+		d.apply(update)
+		d.check(markers, tolerance)
+	}
+
+	if keyframes < 200/7 {
+		t.Errorf("got %d keyframes, expected at least %d", keyframes, 200/7)
+	}
+}
@@ -0,0 +1,195 @@
+// package replay implements a camera that plays back a recorded video or a
+// directory of PNG frames, so the tracker can run without camera hardware.
+package replay
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"gocv.io/x/gocv"
+)
+
+const defaultFrameRate = 30
+
+type Camera struct {
+	resolution image.Point
+	frameRate  float64
+
+	video      *gocv.VideoCapture
+	framePaths []string
+	nextFrame  int
+
+	frames    chan gocv.Mat
+	closed    chan struct{}
+	destroyed chan struct{}
+
+	exposureMutex sync.Mutex
+	exposure      int
+	exposures     []int
+}
+
+// Open prepares the video file or directory of PNG frames at path for
+// playback at frameRate frames per second. Frames are resized to resolution if
+// needed. A frameRate of 0 uses the video's own frame rate.
+func Open(path string, resolution image.Point, frameRate float64) (*Camera, error) {
+	c := &Camera{
+		resolution: resolution,
+		frameRate:  frameRate,
+		frames:     make(chan gocv.Mat),
+		closed:     make(chan struct{}),
+		destroyed:  make(chan struct{}),
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.IsDir() {
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entry := range entries {
+			if !entry.IsDir() && strings.EqualFold(filepath.Ext(entry.Name()), ".png") {
+				c.framePaths = append(c.framePaths, filepath.Join(path, entry.Name()))
+			}
+		}
+		if len(c.framePaths) == 0 {
+			return nil, fmt.Errorf("replay: no PNG frames found in %s", path)
+		}
+		sort.Strings(c.framePaths)
+	} else {
+		video, err := gocv.VideoCaptureFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if !video.IsOpened() {
+			video.Close()
+			return nil, fmt.Errorf("replay: unable to open video %s", path)
+		}
+		c.video = video
+
+		if c.frameRate <= 0 {
+			c.frameRate = video.Get(gocv.VideoCaptureFPS)
+		}
+	}
+
+	if c.frameRate <= 0 {
+		c.frameRate = defaultFrameRate
+	}
+
+	return c, nil
+}
+
+// Frames returns the channel that receives each frame. The same Mat is reused
+// for every frame. It is closed once playback stops.
+func (c *Camera) Frames() <-chan gocv.Mat {
+	return c.frames
+}
+
+// SetExposure records the requested exposure. It has no effect on the frames
+// being played back.
+func (c *Camera) SetExposure(microseconds int) {
+	c.exposureMutex.Lock()
+	defer c.exposureMutex.Unlock()
+
+	c.exposure = microseconds
+	c.exposures = append(c.exposures, microseconds)
+}
+
+func (c *Camera) GetExposure() int {
+	c.exposureMutex.Lock()
+	defer c.exposureMutex.Unlock()
+
+	return c.exposure
+}
+
+// Exposures returns every exposure that has been requested, in order
+func (c *Camera) Exposures() []int {
+	c.exposureMutex.Lock()
+	defer c.exposureMutex.Unlock()
+
+	return append([]int{}, c.exposures...)
+}
+
+// Start plays back frames until Stop is called. Playback loops once the end of
+// the recording is reached.
+func (c *Camera) Start() error {
+	defer close(c.frames)
+	defer close(c.destroyed)
+	if c.video != nil {
+		defer c.video.Close()
+	}
+
+	frame := gocv.NewMat()
+	defer frame.Close()
+	output := gocv.NewMat()
+	defer output.Close()
+
+	ticker := time.NewTicker(time.Duration(float64(time.Second) / c.frameRate))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.closed:
+			return nil
+		case <-ticker.C:
+			err := c.read(&frame)
+			if err != nil {
+				return err
+			}
+
+			if frame.Cols() != c.resolution.X || frame.Rows() != c.resolution.Y {
+				gocv.Resize(frame, &output, c.resolution, 0, 0, gocv.InterpolationLinear)
+			} else {
+				frame.CopyTo(&output)
+			}
+
+			select {
+			case <-c.closed:
+				return nil
+			case c.frames <- output:
+			}
+		}
+	}
+}
+
+func (c *Camera) read(frame *gocv.Mat) error {
+	if c.video != nil {
+		if c.video.Read(frame) && !frame.Empty() {
+			return nil
+		}
+
+		// Rewind to the start of the video
+		c.video.Set(gocv.VideoCapturePosFrames, 0)
+		if !c.video.Read(frame) || frame.Empty() {
+			return errors.New("replay: unable to read frame from video")
+		}
+		return nil
+	}
+
+	path := c.framePaths[c.nextFrame]
+	c.nextFrame = (c.nextFrame + 1) % len(c.framePaths)
+
+	img := gocv.IMRead(path, gocv.IMReadColor)
+	defer img.Close()
+	if img.Empty() {
+		return fmt.Errorf("replay: unable to read frame %s", path)
+	}
+	img.CopyTo(frame)
+	return nil
+}
+
+func (c *Camera) Stop() {
+	close(c.closed)
+	<-c.destroyed
+}
@@ -5,54 +5,69 @@ import (
 	"encoding/json"
 	"fmt"
 	"image"
-	"net/http"
+	"math"
+	"path/filepath"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/tutman96/fantassist.io/tracker/pkg/ble"
+	"github.com/tutman96/fantassist.io/tracker/pkg/channel"
 	"github.com/tutman96/fantassist.io/tracker/pkg/tracker"
 	"github.com/tutman96/fantassist.io/tracker/protos"
 	"gocv.io/x/gocv"
 )
 
 type StateMachine struct {
+	// mutex serialises requests and connection changes, which move the state
+	// machine between states
+	mutex sync.Mutex
+
 	ctx                context.Context
 	state              protos.TrackerGetStatusResponse_TrackerState
 	currentStateCancel context.CancelFunc
 	currentWaitGroup   sync.WaitGroup
 
-	bleChannel *ble.BleChannel
-	tracker    *tracker.Tracker
-}
+	// The outcome of the last pose calibration, written when the calibration
+	// finishes
+	resultMutex        sync.Mutex
+	calibrationResult  protos.TrackerGetCalibrationResponse_CalibrationResult
+	calibrationFailure string
 
-func NewStateMachine() (*StateMachine, error) {
-	bleChannel, err := ble.NewBleChannel()
-	if err != nil {
-		return nil, err
-	}
+	// markerUpdates is set while tracking
+	markerUpdates *markerUpdateEncoder
 
-	tracker, err := tracker.NewTracker(
-		image.Point{X: 1280, Y: 720},
-		nil, // TODO: read this from the calibration storage
-	)
-	if err != nil {
-		return nil, err
-	}
+	channel channel.Channel
+	tracker *tracker.Tracker
+	dataDir string
+}
 
-	sm := &StateMachine{
+// NewStateMachine creates a state machine that serves requests from the given
+// channel using the given tracker. Calibrations are saved to dataDir.
+func NewStateMachine(channel channel.Channel, tracker *tracker.Tracker, dataDir string) *StateMachine {
+	return &StateMachine{
 		ctx:                context.TODO(),
 		state:              protos.TrackerGetStatusResponse_IDLE,
-		bleChannel:         bleChannel,
+		channel:            channel,
 		tracker:            tracker,
+		dataDir:            dataDir,
 		currentStateCancel: func() {},
 	}
+}
 
-	return sm, nil
+// PoseCalibrationPath returns where the pose calibration is stored within the
+// data directory
+func PoseCalibrationPath(dataDir string) string {
+	return filepath.Join(dataDir, "pose-calibration.json")
+}
+
+// CameraIntrinsicsPath returns where the camera intrinsics are stored within the
+// data directory
+func CameraIntrinsicsPath(dataDir string) string {
+	return filepath.Join(dataDir, "camera-intrinsics.json")
 }
 
 func (sm *StateMachine) Start(ctx context.Context) error {
-	err := sm.bleChannel.Start(ctx)
+	err := sm.channel.Start(ctx)
 	if err != nil {
 		return err
 	}
@@ -61,7 +76,7 @@ func (sm *StateMachine) Start(ctx context.Context) error {
 	sm.registerRequestHandlers()
 
 	go func() {
-		connectionChange := sm.bleChannel.OnConnectionStateChange()
+		connectionChange := sm.channel.OnConnectionStateChange()
 		for {
 			select {
 			case <-ctx.Done():
@@ -69,8 +84,11 @@ func (sm *StateMachine) Start(ctx context.Context) error {
 			case connected := <-connectionChange:
 				if !connected {
 					fmt.Println("Disconnected. Stopping calibration and tracking.")
+					sm.mutex.Lock()
 					sm.stopCalibration()
+					sm.stopIntrinsicCalibration()
 					sm.stopTracking()
+					sm.mutex.Unlock()
 				}
 			}
 		}
@@ -80,20 +98,22 @@ func (sm *StateMachine) Start(ctx context.Context) error {
 		sm.tracker.StartCapture()
 		<-ctx.Done()
 
+		sm.mutex.Lock()
 		sm.currentStateCancel()
 		sm.currentWaitGroup.Wait()
+		sm.mutex.Unlock()
 
 		sm.tracker.StopCapture()
 	}()
 
-	http.HandleFunc("/mjpeg", sm.tracker.HandleMJPEG)
-	go http.ListenAndServe(":8080", nil)
-
 	return nil
 }
 
 func (sm *StateMachine) registerRequestHandlers() {
-	sm.bleChannel.AddRequestHandler(func(req *protos.Request) *protos.Response {
+	sm.channel.AddRequestHandler(func(req *protos.Request) *protos.Response {
+		sm.mutex.Lock()
+		defer sm.mutex.Unlock()
+
 		switch req.Message.(type) {
 
 		case *protos.Request_HelloRequest:
@@ -102,18 +122,25 @@ func (sm *StateMachine) registerRequestHandlers() {
 			}
 
 		case *protos.Request_TrackerGetStatusRequest:
+			cameraError := ""
+			if err := sm.tracker.CameraError(); err != nil {
+				cameraError = err.Error()
+			}
+
 			return &protos.Response{
 				Message: &protos.Response_TrackerGetStatusResponse{
 					TrackerGetStatusResponse: &protos.TrackerGetStatusResponse{
-						Uuid:    uuid.New().String(), // TODO: read this from hardware or something
-						Version: "0.0.1",             // TODO: this should come from somewhere
-						State:   sm.state,
+						Uuid:        uuid.New().String(), // TODO: read this from hardware or something
+						Version:     "0.0.1",             // TODO: this should come from somewhere
+						State:       sm.state,
+						CameraError: cameraError,
 					},
 				},
 			}
 
 		case *protos.Request_TrackerSetIdleRequest:
 			sm.stopCalibration()
+			sm.stopIntrinsicCalibration()
 			sm.stopTracking()
 			return &protos.Response{
 				Message: &protos.Response_AckResponse{},
@@ -126,33 +153,91 @@ func (sm *StateMachine) registerRequestHandlers() {
 			}
 
 		case *protos.Request_TrackerGetCalibrationRequest:
+			calibration := sm.tracker.GetPoseCalibration()
+			defer calibration.Close()
+			sm.resultMutex.Lock()
+			result, failure := sm.calibrationResult, sm.calibrationFailure
+			sm.resultMutex.Unlock()
+
+			cornerLocations := make([]*protos.TrackerVector2D, len(calibration.CornerLocations))
+			for i, location := range calibration.CornerLocations {
+				cornerLocations[i] = &protos.TrackerVector2D{X: location.X, Y: location.Y}
+			}
+
 			return &protos.Response{
 				Message: &protos.Response_TrackerGetCalibrationResponse{
 					TrackerGetCalibrationResponse: &protos.TrackerGetCalibrationResponse{
-						FoundCorners: sm.tracker.PoseCalibration.FoundCorners,
-						CornerLocations: []*protos.TrackerVector2D{ // TODO: make these the actual corners
-							{X: 0, Y: 0},
-							{X: 0, Y: 720},
-							{X: 1280, Y: 720},
-							{X: 1280, Y: 0},
-						},
+						FoundCorners:       calibration.FoundCorners,
+						CornerLocations:    cornerLocations,
+						ReprojectionError:  calibration.ReprojectionError,
+						Inliers:            calibration.InlierMask,
+						Residuals:          calibration.Residuals,
+						CornerDeviations:   calibration.CornerDeviations,
+						Result:             result,
+						FailureReason:      failure,
+						CalibratedMarkers:  calibration.MarkerIds,
+						NeedsRecalibration: calibration.NeedsRecalibration,
 					},
 				},
 			}
 
+		case *protos.Request_TrackerStartIntrinsicCalibrationRequest:
+			sm.startIntrinsicCalibration(req.Message.(*protos.Request_TrackerStartIntrinsicCalibrationRequest))
+			return &protos.Response{
+				Message: &protos.Response_AckResponse{},
+			}
+
+		case *protos.Request_TrackerGetIntrinsicCalibrationRequest:
+			response := &protos.TrackerGetIntrinsicCalibrationResponse{
+				ViewsCaptured: int32(sm.tracker.IntrinsicViewsCaptured()),
+				ViewsRequired: tracker.MinIntrinsicCalibrationViews,
+			}
+			if intrinsics := sm.tracker.GetIntrinsics(); intrinsics != nil {
+				response.Calibrated = true
+				response.ReprojectionError = intrinsics.ReprojectionError
+			}
+
+			return &protos.Response{
+				Message: &protos.Response_TrackerGetIntrinsicCalibrationResponse{
+					TrackerGetIntrinsicCalibrationResponse: response,
+				},
+			}
+
 		case *protos.Request_TrackerStartTrackingRequest:
 			sm.startTracking(req.Message.(*protos.Request_TrackerStartTrackingRequest))
 			return &protos.Response{
 				Message: &protos.Response_AckResponse{},
 			}
 
+		case *protos.Request_TrackerResyncMarkersRequest:
+			if sm.markerUpdates != nil {
+				sm.markerUpdates.requestResync()
+			}
+			return &protos.Response{
+				Message: &protos.Response_AckResponse{},
+			}
+
+		case *protos.Request_TrackerSetDisplayMaskRequest:
+			sm.setDisplayMask(req.Message.(*protos.Request_TrackerSetDisplayMaskRequest))
+			return &protos.Response{
+				Message: &protos.Response_AckResponse{},
+			}
+
+		case *protos.Request_TrackerSetDetectionParametersRequest:
+			sm.setDetectionParameters(req.Message.(*protos.Request_TrackerSetDetectionParametersRequest))
+			return &protos.Response{
+				Message: &protos.Response_AckResponse{},
+			}
+
 		case *protos.Request_TrackerGetMarkerLocationRequest:
-			vectors := sm.getMarkerVectors()
+			markers := sm.getMarkers()
 
 			return &protos.Response{
 				Message: &protos.Response_TrackerGetMarkerLocationResponse{
 					TrackerGetMarkerLocationResponse: &protos.TrackerGetMarkerLocationResponse{
-						MarkerLocations: vectors,
+						MarkerLocations: markerLocations(markers),
+						MarkerColors:    markerColors(markers),
+						Markers:         markers,
 					},
 				},
 			}
@@ -168,42 +253,75 @@ func (sm *StateMachine) startCalibration(req *protos.Request_TrackerStartCalibra
 		return
 	} else if sm.state == protos.TrackerGetStatusResponse_TRACKING {
 		sm.stopTracking()
+	} else if sm.state == protos.TrackerGetStatusResponse_CALIBRATING_INTRINSICS {
+		sm.stopIntrinsicCalibration()
 	}
 
 	fmt.Println("Starting calibration")
 	ctx, cancel := context.WithCancel(sm.ctx)
 	sm.currentStateCancel = cancel
+	sm.setCalibrationResult(protos.TrackerGetCalibrationResponse_NONE, "")
 
-	realCorners := make([]gocv.Point3f, len(req.TrackerStartCalibrationRequest.Corners))
+	markerIds := req.TrackerStartCalibrationRequest.MarkerIds
+	references := make([]tracker.ReferenceMarker, len(req.TrackerStartCalibrationRequest.Corners))
 	for i, corner := range req.TrackerStartCalibrationRequest.Corners {
-		realCorners[i] = gocv.NewPoint3f(
-			float32(corner.X),
-			float32(corner.Y),
-			0,
-		)
+		id := i + 1
+		if i < len(markerIds) {
+			id = int(markerIds[i])
+		}
+
+		references[i] = tracker.ReferenceMarker{
+			ID: id,
+			Position: gocv.NewPoint3f(
+				float32(corner.X),
+				float32(corner.Y),
+				0,
+			),
+		}
 	}
 
 	sm.currentWaitGroup.Add(1)
 	go func() {
 		defer sm.currentWaitGroup.Done()
 
-		sm.tracker.EstimatePose(
+		err := sm.tracker.EstimatePose(
 			ctx,
-			realCorners,
+			references,
 			50*time.Millisecond,
 		)
+		if err != nil {
+			fmt.Println("Error estimating pose:", err)
+			sm.setCalibrationResult(protos.TrackerGetCalibrationResponse_FAILED, err.Error())
+			return
+		}
+		sm.setCalibrationResult(protos.TrackerGetCalibrationResponse_PASSED, "")
 
-		output, err := json.Marshal(sm.tracker.PoseCalibration)
+		calibration := sm.tracker.GetPoseCalibration()
+		output, err := json.Marshal(calibration)
+		calibration.Close()
 		if err != nil {
 			fmt.Println("Error marshalling calibration data:", err)
 			return
 		}
 		fmt.Println("Calibration data:", string(output))
+
+		err = sm.tracker.SavePoseCalibration(PoseCalibrationPath(sm.dataDir))
+		if err != nil {
+			fmt.Println("Error saving calibration data:", err)
+		}
 	}()
 
 	sm.state = protos.TrackerGetStatusResponse_CALIBRATING
 }
 
+func (sm *StateMachine) setCalibrationResult(result protos.TrackerGetCalibrationResponse_CalibrationResult, failure string) {
+	sm.resultMutex.Lock()
+	defer sm.resultMutex.Unlock()
+
+	sm.calibrationResult = result
+	sm.calibrationFailure = failure
+}
+
 func (sm *StateMachine) stopCalibration() {
 	if sm.state != protos.TrackerGetStatusResponse_CALIBRATING {
 		return
@@ -215,24 +333,145 @@ func (sm *StateMachine) stopCalibration() {
 	sm.state = protos.TrackerGetStatusResponse_IDLE
 }
 
+func (sm *StateMachine) startIntrinsicCalibration(req *protos.Request_TrackerStartIntrinsicCalibrationRequest) {
+	columns := req.TrackerStartIntrinsicCalibrationRequest.Columns
+	rows := req.TrackerStartIntrinsicCalibrationRequest.Rows
+	squareSize := req.TrackerStartIntrinsicCalibrationRequest.SquareSize
+	if columns <= 2 || rows <= 2 || squareSize <= 0 {
+		fmt.Printf("Invalid checkerboard for intrinsic calibration: %dx%d corners, %f square size\n", columns, rows, squareSize)
+		return
+	}
+
+	if sm.state == protos.TrackerGetStatusResponse_CALIBRATING_INTRINSICS {
+		return
+	} else if sm.state == protos.TrackerGetStatusResponse_CALIBRATING {
+		sm.stopCalibration()
+	} else if sm.state == protos.TrackerGetStatusResponse_TRACKING {
+		sm.stopTracking()
+	}
+
+	fmt.Println("Starting intrinsic calibration")
+	ctx, cancel := context.WithCancel(sm.ctx)
+	sm.currentStateCancel = cancel
+
+	patternSize := image.Point{X: int(columns), Y: int(rows)}
+
+	sm.currentWaitGroup.Add(1)
+	go func() {
+		defer sm.currentWaitGroup.Done()
+
+		// Capture also ends on its own once MaxIntrinsicCalibrationViews views
+		// are captured, in which case nothing else moves the state back to idle
+		defer func() {
+			if ctx.Err() == nil {
+				go sm.finishIntrinsicCalibration(ctx)
+			}
+		}()
+
+		intrinsics, err := sm.tracker.CalibrateIntrinsics(
+			ctx,
+			patternSize,
+			squareSize,
+			100*time.Millisecond,
+		)
+		if err != nil {
+			fmt.Println("Error calibrating intrinsics:", err)
+			return
+		}
+
+		sm.tracker.SetIntrinsics(intrinsics)
+
+		err = intrinsics.Save(CameraIntrinsicsPath(sm.dataDir))
+		if err != nil {
+			fmt.Println("Error saving camera intrinsics:", err)
+		}
+
+		// Overwrite the saved pose so it isn't loaded again with the new
+		// intrinsics
+		calibration := sm.tracker.GetPoseCalibration()
+		needsRecalibration := calibration.NeedsRecalibration
+		calibration.Close()
+		if needsRecalibration {
+			err = sm.tracker.SavePoseCalibration(PoseCalibrationPath(sm.dataDir))
+			if err != nil {
+				fmt.Println("Error saving calibration data:", err)
+			}
+		}
+	}()
+
+	sm.state = protos.TrackerGetStatusResponse_CALIBRATING_INTRINSICS
+}
+
+// finishIntrinsicCalibration returns to idle after an intrinsic calibration
+// ended without being stopped. It does nothing if ctx was cancelled since, as
+// the state has already moved on.
+func (sm *StateMachine) finishIntrinsicCalibration(ctx context.Context) {
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+
+	if ctx.Err() != nil {
+		return
+	}
+	sm.stopIntrinsicCalibration()
+}
+
+func (sm *StateMachine) stopIntrinsicCalibration() {
+	if sm.state != protos.TrackerGetStatusResponse_CALIBRATING_INTRINSICS {
+		return
+	}
+
+	fmt.Println("Stopping intrinsic calibration")
+	sm.currentStateCancel()
+	sm.currentWaitGroup.Wait()
+	sm.state = protos.TrackerGetStatusResponse_IDLE
+}
+
 func (sm *StateMachine) startTracking(req *protos.Request_TrackerStartTrackingRequest) {
 	if sm.state == protos.TrackerGetStatusResponse_TRACKING {
 		return
 	} else if sm.state == protos.TrackerGetStatusResponse_CALIBRATING {
 		sm.stopCalibration()
+	} else if sm.state == protos.TrackerGetStatusResponse_CALIBRATING_INTRINSICS {
+		sm.stopIntrinsicCalibration()
 	}
 
 	fmt.Println("Starting tracking")
 	ctx, cancel := context.WithCancel(sm.ctx)
 	sm.currentStateCancel = cancel
 
+	lostGracePeriod := tracker.DefaultLostGracePeriod
+	if req.TrackerStartTrackingRequest.LostGracePeriodMs > 0 {
+		lostGracePeriod = time.Duration(req.TrackerStartTrackingRequest.LostGracePeriodMs) * time.Millisecond
+	}
+	tableMargin := tracker.DefaultTableMargin
+	if req.TrackerStartTrackingRequest.TableMargin > 0 {
+		tableMargin = float64(req.TrackerStartTrackingRequest.TableMargin)
+	}
+	sm.tracker.SetTrackingOptions(float64(req.TrackerStartTrackingRequest.MarkerHeight), lostGracePeriod, tableMargin)
+	sm.tracker.SetLEDPairHeadings(req.TrackerStartTrackingRequest.LedPairHeadings)
+
+	mode := req.TrackerStartTrackingRequest.Mode
+	sm.currentWaitGroup.Add(1)
+	go func() {
+		defer sm.currentWaitGroup.Done()
+
+		if mode == protos.TrackerStartTrackingRequest_ARUCO_TAGS {
+			sm.tracker.DetectTags(ctx)
+		} else {
+			sm.tracker.DetectMarkers(ctx)
+		}
+	}()
+
 	sm.currentWaitGroup.Add(1)
 	go func() {
 		defer sm.currentWaitGroup.Done()
 
-		sm.tracker.DetectMarkers(ctx)
+		sm.sendMarkerEvents(ctx)
 	}()
 
+	markerUpdates := newMarkerUpdateEncoder(req.TrackerStartTrackingRequest)
+	sm.markerUpdates = markerUpdates
+
 	if req.TrackerStartTrackingRequest.UpdateRateMs > 0 {
 		sm.currentWaitGroup.Add(1)
 		go func() {
@@ -244,13 +483,13 @@ func (sm *StateMachine) startTracking(req *protos.Request_TrackerStartTrackingRe
 				case <-ctx.Done():
 					return
 				case <-ticker.C:
-					vectors := sm.getMarkerVectors()
-					markerUpdate := &protos.TrackerUpdateMarkerLocationRequest{
-						MarkerLocations: vectors,
+					markerUpdate := markerUpdates.encode(sm.getMarkers())
+					if markerUpdate == nil {
+						continue
 					}
 
 					// Leave RequestID empty to indicate that this is a broadcast
-					sm.bleChannel.SendPacket(&protos.Packet{
+					sm.channel.SendPacket(&protos.Packet{
 						Message: &protos.Packet_Request{
 							Request: &protos.Request{
 								Message: &protos.Request_TrackerUpdateMarkerLocationRequest{
@@ -276,31 +515,181 @@ func (sm *StateMachine) stopTracking() {
 	sm.currentStateCancel()
 	sm.currentWaitGroup.Wait()
 	sm.state = protos.TrackerGetStatusResponse_IDLE
+	sm.markerUpdates = nil
+}
+
+func (sm *StateMachine) setDisplayMask(req *protos.Request_TrackerSetDisplayMaskRequest) {
+	displayImage := gocv.NewMat()
+	if len(req.TrackerSetDisplayMaskRequest.DisplayImage) > 0 {
+		decoded, err := gocv.IMDecode(req.TrackerSetDisplayMaskRequest.DisplayImage, gocv.IMReadColor)
+		if err != nil || decoded.Empty() {
+			fmt.Println("Error decoding display image:", err)
+			decoded.Close()
+		} else {
+			displayImage.Close()
+			displayImage = decoded
+		}
+	}
+	defer displayImage.Close()
+
+	exclusions := make([][]gocv.Point2f, len(req.TrackerSetDisplayMaskRequest.ExclusionPolygons))
+	for i, polygon := range req.TrackerSetDisplayMaskRequest.ExclusionPolygons {
+		exclusions[i] = make([]gocv.Point2f, len(polygon.Vertices))
+		for j, vertex := range polygon.Vertices {
+			exclusions[i][j] = vector2DToPoint(vertex)
+		}
+	}
+
+	sm.tracker.SetDisplayMask(tracker.DisplayMask{
+		Image:      displayImage,
+		Origin:     vector2DToPoint(req.TrackerSetDisplayMaskRequest.DisplayOrigin),
+		Size:       vector2DToPoint(req.TrackerSetDisplayMaskRequest.DisplaySize),
+		Exclusions: exclusions,
+	})
 }
 
-func (sm *StateMachine) getMarkerVectors() map[int32]*protos.TrackerVector2D {
+func (sm *StateMachine) setDetectionParameters(req *protos.Request_TrackerSetDetectionParametersRequest) {
+	r := req.TrackerSetDetectionParametersRequest
+	params := tracker.DefaultDetectionParameters
+	if r.MinBrightness > 0 {
+		params.MinBrightness = float64(r.MinBrightness)
+	}
+	if r.AdaptiveOffset > 0 {
+		params.AdaptiveOffset = float64(r.AdaptiveOffset)
+	}
+	if r.AdaptiveBlockSize > 0 {
+		params.AdaptiveBlockSize = int(r.AdaptiveBlockSize)
+	}
+	if r.BackgroundLearningRate > 0 {
+		params.BackgroundLearningRate = float64(r.BackgroundLearningRate)
+	}
+	if r.ForegroundThreshold > 0 {
+		params.ForegroundThreshold = float64(r.ForegroundThreshold)
+	}
+	if r.DisableBackgroundModel {
+		params.BackgroundLearningRate = 0
+	}
+
+	sm.tracker.SetDetectionParameters(params, r.ResetBackground)
+}
+
+func vector2DToPoint(v *protos.TrackerVector2D) gocv.Point2f {
+	if v == nil {
+		return gocv.Point2f{}
+	}
+	return gocv.Point2f{X: v.X, Y: v.Y}
+}
+
+func (sm *StateMachine) getMarkers() map[int32]*protos.TrackerMarker {
 	markers := sm.tracker.GetMarkers()
 
-	vectors := make(map[int32]*protos.TrackerVector2D)
+	result := make(map[int32]*protos.TrackerMarker)
 	for _, marker := range markers {
-		if marker.LastSeen.Sub(marker.FirstSeen) < 100*time.Millisecond {
+		if !marker.Appeared {
 			fmt.Println("Marker", marker.Identifier, "is too young")
 			continue
 		}
 
-		if len(vectors) >= 30 {
-			fmt.Println("More than 30 markers detected, omitting those from the packet")
-			break
-		}
+		result[marker.Key()] = sm.markerToProto(marker)
+	}
+	return result
+}
+
+func (sm *StateMachine) markerToProto(marker *tracker.Marker) *protos.TrackerMarker {
+	location := sm.tracker.ConvertPixelTo3D(marker.Position)
 
-		location := sm.tracker.ConvertPixelTo3D(marker.Position)
+	// Velocity is mapped onto the table as the distance moved in a second
+	ahead := sm.tracker.ConvertPixelTo3D(gocv.Point2f{
+		X: marker.Position.X + marker.Velocity.X,
+		Y: marker.Position.Y + marker.Velocity.Y,
+	})
+
+	// Heading is mapped onto the table by the direction a point just ahead of
+	// the marker ends up in
+	heading := float32(0)
+	if marker.HasHeading {
+		facing := sm.tracker.ConvertPixelTo3D(gocv.Point2f{
+			X: marker.Position.X + float32(10*math.Cos(marker.Heading)),
+			Y: marker.Position.Y + float32(10*math.Sin(marker.Heading)),
+		})
+		heading = float32(math.Atan2(float64(facing.Y-location.Y), float64(facing.X-location.X)))
+	}
 
-		fmt.Println("Marker", marker.Identifier, "at", location)
+	return &protos.TrackerMarker{
+		Location:          &protos.TrackerVector2D{X: location.X, Y: location.Y},
+		Velocity:          &protos.TrackerVector2D{X: ahead.X - location.X, Y: ahead.Y - location.Y},
+		Pixel:             &protos.TrackerVector2D{X: marker.Position.X, Y: marker.Position.Y},
+		Color:             protos.TrackerMarkerColor(marker.Color),
+		Area:              float32(marker.Area),
+		Circularity:       float32(marker.Circularity),
+		Brightness:        float32(marker.Brightness),
+		Lost:              marker.Lost,
+		Heading:           heading,
+		HasHeading:        marker.HasHeading,
+		HeadingConfidence: float32(marker.HeadingConfidence),
+		HeadingSource:     protos.TrackerMarker_HeadingSource(marker.HeadingSource),
+		PairedKey:         marker.PairedKey,
+	}
+}
 
-		vectors[int32(marker.Identifier)] = &protos.TrackerVector2D{
-			X: location.X,
-			Y: location.Y,
+// sendMarkerEvents broadcasts marker events as they happen until the context
+// is cancelled. Events that happen together are sent in one packet.
+func (sm *StateMachine) sendMarkerEvents(ctx context.Context) {
+	events, deregister := sm.tracker.RegisterMarkerEventListener()
+	defer deregister()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-events:
+			batch := []*protos.TrackerMarkerEvent{sm.markerEventToProto(event)}
+		drain:
+			for {
+				select {
+				case event := <-events:
+					batch = append(batch, sm.markerEventToProto(event))
+				default:
+					break drain
+				}
+			}
+
+			// Leave RequestID empty to indicate that this is a broadcast
+			sm.channel.SendPacket(&protos.Packet{
+				Message: &protos.Packet_Request{
+					Request: &protos.Request{
+						Message: &protos.Request_TrackerMarkerEventsRequest{
+							TrackerMarkerEventsRequest: &protos.TrackerMarkerEventsRequest{
+								Events: batch,
+							},
+						},
+					},
+				},
+			})
 		}
 	}
-	return vectors
+}
+
+func (sm *StateMachine) markerEventToProto(event tracker.MarkerEvent) *protos.TrackerMarkerEvent {
+	return &protos.TrackerMarkerEvent{
+		Type:   protos.TrackerMarkerEvent_EventType(event.Type),
+		Key:    event.Key,
+		Marker: sm.markerToProto(&event.Marker),
+	}
+}
+
+func markerLocations(markers map[int32]*protos.TrackerMarker) map[int32]*protos.TrackerVector2D {
+	locations := make(map[int32]*protos.TrackerVector2D, len(markers))
+	for key, marker := range markers {
+		locations[key] = marker.Location
+	}
+	return locations
+}
+
+func markerColors(markers map[int32]*protos.TrackerMarker) map[int32]protos.TrackerMarkerColor {
+	colors := make(map[int32]protos.TrackerMarkerColor, len(markers))
+	for key, marker := range markers {
+		colors[key] = marker.Color
+	}
+	return colors
 }
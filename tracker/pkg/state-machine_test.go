@@ -0,0 +1,192 @@
+package pkg
+
+import (
+	"context"
+	"image"
+	"testing"
+	"time"
+
+	"github.com/tutman96/fantassist.io/tracker/pkg/channel"
+	"github.com/tutman96/fantassist.io/tracker/pkg/synthetic"
+	"github.com/tutman96/fantassist.io/tracker/pkg/tracker"
+	"github.com/tutman96/fantassist.io/tracker/protos"
+)
+
+// startStateMachine runs a state machine against the synthetic camera and
+// returns the app's end of a loopback channel connected to it
+func startStateMachine(t *testing.T, resolution image.Point) *channel.LoopbackChannel {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	camera := synthetic.NewCamera(synthetic.DemoScene(resolution), resolution, 30)
+	trackerEnd, appEnd := channel.NewLoopbackPair()
+
+	sm := NewStateMachine(trackerEnd, tracker.NewTracker(resolution, camera, nil, nil), t.TempDir())
+	err := sm.Start(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	appEnd.Start(ctx)
+
+	return appEnd
+}
+
+func getState(app *channel.LoopbackChannel) protos.TrackerGetStatusResponse_TrackerState {
+	response := app.Request(&protos.Request{
+		Message: &protos.Request_TrackerGetStatusRequest{
+			TrackerGetStatusRequest: &protos.TrackerGetStatusRequest{},
+		},
+	})
+	return response.GetTrackerGetStatusResponse().GetState()
+}
+
+func TestStateMachineRequests(t *testing.T) {
+	app := startStateMachine(t, image.Pt(320, 180))
+
+	if state := getState(app); state != protos.TrackerGetStatusResponse_IDLE {
+		t.Fatalf("expected the state machine to start idle, got %v", state)
+	}
+
+	response := app.Request(&protos.Request{
+		Message: &protos.Request_TrackerStartTrackingRequest{
+			TrackerStartTrackingRequest: &protos.TrackerStartTrackingRequest{},
+		},
+	})
+	if _, ok := response.Message.(*protos.Response_AckResponse); !ok {
+		t.Errorf("expected starting tracking to be acknowledged, got %v", response)
+	}
+	if state := getState(app); state != protos.TrackerGetStatusResponse_TRACKING {
+		t.Errorf("expected to be tracking, got %v", state)
+	}
+
+	app.Request(&protos.Request{
+		Message: &protos.Request_TrackerSetIdleRequest{
+			TrackerSetIdleRequest: &protos.TrackerSetIdleRequest{},
+		},
+	})
+	if state := getState(app); state != protos.TrackerGetStatusResponse_IDLE {
+		t.Errorf("expected to be idle again, got %v", state)
+	}
+}
+
+func TestStateMachineRejectsInvalidCheckerboard(t *testing.T) {
+	app := startStateMachine(t, image.Pt(320, 180))
+
+	app.Request(&protos.Request{
+		Message: &protos.Request_TrackerStartIntrinsicCalibrationRequest{
+			TrackerStartIntrinsicCalibrationRequest: &protos.TrackerStartIntrinsicCalibrationRequest{
+				Columns: 9,
+				Rows:    6,
+			},
+		},
+	})
+	if state := getState(app); state != protos.TrackerGetStatusResponse_IDLE {
+		t.Errorf("expected a checkerboard without a square size to be rejected, got %v", state)
+	}
+}
+
+func TestStateMachineCalibration(t *testing.T) {
+	// The reference markers are too small to detect at lower resolutions
+	resolution := image.Pt(1280, 720)
+	app := startStateMachine(t, resolution)
+
+	request := &protos.TrackerStartCalibrationRequest{}
+	for _, marker := range synthetic.DemoScene(resolution).CornerMarkers() {
+		request.Corners = append(request.Corners, &protos.TrackerVector2D{X: marker.Position.X, Y: marker.Position.Y})
+		request.MarkerIds = append(request.MarkerIds, int32(marker.ID))
+	}
+
+	response := app.Request(&protos.Request{
+		Message: &protos.Request_TrackerStartCalibrationRequest{
+			TrackerStartCalibrationRequest: request,
+		},
+	})
+	if _, ok := response.Message.(*protos.Response_AckResponse); !ok {
+		t.Errorf("expected starting calibration to be acknowledged, got %v", response)
+	}
+	if state := getState(app); state != protos.TrackerGetStatusResponse_CALIBRATING {
+		t.Fatalf("expected to be calibrating, got %v", state)
+	}
+
+	getCalibration := func() *protos.TrackerGetCalibrationResponse {
+		return app.Request(&protos.Request{
+			Message: &protos.Request_TrackerGetCalibrationRequest{
+				TrackerGetCalibrationRequest: &protos.TrackerGetCalibrationRequest{},
+			},
+		}).GetTrackerGetCalibrationResponse()
+	}
+	if result := getCalibration().GetResult(); result != protos.TrackerGetCalibrationResponse_NONE {
+		t.Errorf("expected no result while calibrating, got %v", result)
+	}
+
+	// Give the markers time to be seen in enough frames to count as steady.
+	// Calibration is computed once it is stopped.
+	time.Sleep(1500 * time.Millisecond)
+	app.Request(&protos.Request{
+		Message: &protos.Request_TrackerSetIdleRequest{
+			TrackerSetIdleRequest: &protos.TrackerSetIdleRequest{},
+		},
+	})
+
+	calibration := getCalibration()
+	if calibration.GetResult() != protos.TrackerGetCalibrationResponse_PASSED {
+		t.Fatalf("expected calibration to pass, got %v: %s", calibration.GetResult(), calibration.GetFailureReason())
+	}
+	if len(calibration.GetCalibratedMarkers()) != len(request.Corners) {
+		t.Errorf("expected all %d markers to be used, got %v", len(request.Corners), calibration.GetCalibratedMarkers())
+	}
+}
+
+func TestStateMachineMarkerUpdates(t *testing.T) {
+	app := startStateMachine(t, image.Pt(1280, 720))
+
+	updates := make(chan *protos.TrackerUpdateMarkerLocationRequest, 64)
+	app.AddRequestHandler(func(req *protos.Request) *protos.Response {
+		if update := req.GetTrackerUpdateMarkerLocationRequest(); update != nil {
+			updates <- update
+		}
+		return nil
+	})
+
+	const keyframeInterval = 5
+	app.Request(&protos.Request{
+		Message: &protos.Request_TrackerStartTrackingRequest{
+			TrackerStartTrackingRequest: &protos.TrackerStartTrackingRequest{
+				UpdateRateMs:     50,
+				DeltaUpdates:     true,
+				KeyframeInterval: keyframeInterval,
+			},
+		},
+	})
+
+	markersSeen := false
+	keyframes := 0
+	for sequence := uint32(1); sequence <= 3*keyframeInterval; sequence++ {
+		var update *protos.TrackerUpdateMarkerLocationRequest
+		select {
+		case update = <-updates:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for marker update %d", sequence)
+		}
+
+		if update.GetSequence() != sequence {
+			t.Fatalf("expected marker update %d, got %d", sequence, update.GetSequence())
+		}
+		if sequence == 1 && !update.GetKeyframe() {
+			t.Errorf("expected the first marker update to be a keyframe")
+		}
+		if update.GetKeyframe() {
+			keyframes++
+		}
+		if len(update.GetMarkers()) > 0 {
+			markersSeen = true
+		}
+	}
+
+	if keyframes < 2 {
+		t.Errorf("expected a keyframe at least every %d updates, got %d keyframes", keyframeInterval, keyframes)
+	}
+	if !markersSeen {
+		t.Errorf("expected the synthetic tokens to be sent as markers")
+	}
+}
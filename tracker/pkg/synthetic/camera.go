@@ -0,0 +1,319 @@
+// package synthetic implements a camera that renders a virtual table with
+// moving LED tokens and ArUco calibration markers. The ground truth of every
+// frame is available, so the accuracy of the tracker can be measured without
+// any hardware.
+package synthetic
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"sync"
+	"time"
+
+	"gocv.io/x/gocv"
+)
+
+const (
+	// Exposures at which the display and the LEDs are rendered at their
+	// nominal brightness. The LEDs are far brighter than the display, so the
+	// tracker's short marker detection exposure only picks up the LEDs while
+	// the long calibration exposure shows the display.
+	displayExposure = 15000
+	ledExposure     = 1000
+
+	markerImageSize = 200
+)
+
+var (
+	roomColor    = color.RGBA{R: 60, G: 60, B: 60}
+	displayColor = color.RGBA{R: 10, G: 10, B: 10}
+	ledColor     = color.RGBA{R: 255, G: 40, B: 40}
+)
+
+// TokenState is the ground truth of a token in a rendered frame
+type TokenState struct {
+	ID int
+
+	// Position of the token's base on the table
+	Position gocv.Point2f
+
+	// Pixel location of the token's LED in the frame
+	Pixel gocv.Point2f
+
+	// Whether the LED was lit in the frame
+	Lit bool
+}
+
+// GroundTruth describes what was rendered in a frame
+type GroundTruth struct {
+	Elapsed time.Duration
+	Tokens  []TokenState
+}
+
+type Camera struct {
+	scene      Scene
+	resolution image.Point
+	frameRate  float64
+	rotation   [3][3]float64
+
+	frames    chan gocv.Mat
+	closed    chan struct{}
+	destroyed chan struct{}
+
+	mutex       sync.Mutex
+	exposure    int
+	exposures   []int
+	groundTruth GroundTruth
+}
+
+// NewCamera creates a camera that renders the scene at the given resolution
+// and frame rate. Time in the scene advances by exactly one frame interval per
+// frame, regardless of how quickly frames are consumed.
+func NewCamera(scene Scene, resolution image.Point, frameRate float64) *Camera {
+	return &Camera{
+		scene:      scene,
+		resolution: resolution,
+		frameRate:  frameRate,
+		rotation:   scene.Camera.rotation(),
+		frames:     make(chan gocv.Mat),
+		closed:     make(chan struct{}),
+		destroyed:  make(chan struct{}),
+		exposure:   ledExposure,
+	}
+}
+
+// Frames returns the channel that receives each frame. The same Mat is reused
+// for every frame. It is closed once the camera stops.
+func (c *Camera) Frames() <-chan gocv.Mat {
+	return c.frames
+}
+
+// SetExposure scales the brightness of subsequent frames
+func (c *Camera) SetExposure(microseconds int) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.exposure = microseconds
+	c.exposures = append(c.exposures, microseconds)
+}
+
+func (c *Camera) GetExposure() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.exposure
+}
+
+// Exposures returns every exposure that has been requested, in order
+func (c *Camera) Exposures() []int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return append([]int{}, c.exposures...)
+}
+
+// GroundTruth returns what was rendered in the most recent frame
+func (c *Camera) GroundTruth() GroundTruth {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	truth := c.groundTruth
+	truth.Tokens = append([]TokenState{}, truth.Tokens...)
+	return truth
+}
+
+// Project returns the pixel location of a point on the table. The point's Z is
+// its height above the table.
+func (c *Camera) Project(point gocv.Point3f) gocv.Point2f {
+	pose := c.scene.Camera
+
+	// The table's z axis points into the table, so heights are negative
+	d := [3]float64{
+		float64(point.X - pose.Position.X),
+		float64(point.Y - pose.Position.Y),
+		float64(pose.Position.Z - point.Z),
+	}
+
+	var p [3]float64
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			p[i] += c.rotation[i][j] * d[j]
+		}
+	}
+
+	return gocv.Point2f{
+		X: float32(pose.FocalLength*p[0]/p[2]) + float32(c.resolution.X)/2,
+		Y: float32(pose.FocalLength*p[1]/p[2]) + float32(c.resolution.Y)/2,
+	}
+}
+
+func (c *Camera) Start() error {
+	defer close(c.frames)
+	defer close(c.destroyed)
+
+	renderer := newRenderer(c)
+	defer renderer.Close()
+
+	output := gocv.NewMat()
+	defer output.Close()
+
+	interval := time.Duration(float64(time.Second) / c.frameRate)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for frame := 0; ; frame++ {
+		select {
+		case <-c.closed:
+			return nil
+		case <-ticker.C:
+			elapsed := time.Duration(frame) * interval
+			truth := renderer.render(frame, elapsed, c.GetExposure(), &output)
+
+			c.mutex.Lock()
+			c.groundTruth = truth
+			c.mutex.Unlock()
+
+			select {
+			case <-c.closed:
+				return nil
+			case c.frames <- output:
+			}
+		}
+	}
+}
+
+func (c *Camera) Stop() {
+	close(c.closed)
+	<-c.destroyed
+}
+
+type renderer struct {
+	camera  *Camera
+	display gocv.Mat
+	leds    gocv.Mat
+}
+
+// newRenderer draws the static parts of the scene, the table and calibration
+// markers, once up front
+func newRenderer(c *Camera) *renderer {
+	r := &renderer{
+		camera:  c,
+		display: gocv.NewMatWithSize(c.resolution.Y, c.resolution.X, gocv.MatTypeCV8UC3),
+		leds:    gocv.NewMatWithSize(c.resolution.Y, c.resolution.X, gocv.MatTypeCV8UC3),
+	}
+
+	r.display.SetTo(gocv.NewScalar(float64(roomColor.B), float64(roomColor.G), float64(roomColor.R), 0))
+
+	table := []image.Point{
+		c.pixel(gocv.Point2f{X: 0, Y: 0}),
+		c.pixel(gocv.Point2f{X: c.scene.Width, Y: 0}),
+		c.pixel(gocv.Point2f{X: c.scene.Width, Y: c.scene.Height}),
+		c.pixel(gocv.Point2f{X: 0, Y: c.scene.Height}),
+	}
+	tablePoly := gocv.NewPointsVectorFromPoints([][]image.Point{table})
+	defer tablePoly.Close()
+	gocv.FillPoly(&r.display, tablePoly, displayColor)
+
+	for _, marker := range c.scene.CornerMarkers() {
+		r.drawCornerMarker(marker)
+	}
+
+	return r
+}
+
+// drawCornerMarker draws the marker inverted, as the tracker detects markers in
+// the inverted blue channel
+func (r *renderer) drawCornerMarker(marker CornerMarker) {
+	c := r.camera
+
+	markerImage := gocv.NewMat()
+	defer markerImage.Close()
+	gocv.ArucoGenerateImageMarker(gocv.ArucoDictArucoOriginal, marker.ID, markerImageSize, markerImage, 1)
+	gocv.BitwiseNot(markerImage, &markerImage)
+
+	markerBGR := gocv.NewMat()
+	defer markerBGR.Close()
+	gocv.CvtColor(markerImage, &markerBGR, gocv.ColorGrayToBGR)
+
+	src := gocv.NewPoint2fVectorFromPoints([]gocv.Point2f{
+		{X: 0, Y: 0},
+		{X: markerImageSize, Y: 0},
+		{X: markerImageSize, Y: markerImageSize},
+		{X: 0, Y: markerImageSize},
+	})
+	defer src.Close()
+
+	corners := make([]gocv.Point2f, len(marker.Corners))
+	for i, corner := range marker.Corners {
+		corners[i] = c.Project(gocv.Point3f{X: corner.X, Y: corner.Y})
+	}
+	dst := gocv.NewPoint2fVectorFromPoints(corners)
+	defer dst.Close()
+
+	transform := gocv.GetPerspectiveTransform2f(src, dst)
+	defer transform.Close()
+
+	gocv.WarpPerspectiveWithParams(markerBGR, &r.display, transform, c.resolution, gocv.InterpolationLinear, gocv.BorderTransparent, color.RGBA{})
+}
+
+// render draws the tokens at the given frame and time and combines them with
+// the display according to the exposure
+func (r *renderer) render(frame int, elapsed time.Duration, exposure int, output *gocv.Mat) GroundTruth {
+	c := r.camera
+	truth := GroundTruth{
+		Elapsed: elapsed,
+		Tokens:  make([]TokenState, len(c.scene.Tokens)),
+	}
+
+	r.leds.SetTo(gocv.NewScalar(0, 0, 0, 0))
+	for i, token := range c.scene.Tokens {
+		position := token.Path(elapsed)
+		center := c.Project(gocv.Point3f{X: position.X, Y: position.Y, Z: token.Height})
+		edge := c.Project(gocv.Point3f{X: position.X + token.Radius, Y: position.Y, Z: token.Height})
+
+		lit := len(token.Blink) == 0 || token.Blink[frame%len(token.Blink)]
+		if lit {
+			radius := int(distance(center, edge) + 0.5)
+			if radius < 1 {
+				radius = 1
+			}
+			tokenColor := token.Color
+			if tokenColor == (color.RGBA{}) {
+				tokenColor = ledColor
+			}
+			gocv.Circle(&r.leds, image.Pt(int(center.X+0.5), int(center.Y+0.5)), radius, tokenColor, -1)
+		}
+
+		truth.Tokens[i] = TokenState{
+			ID:       token.ID,
+			Position: position,
+			Pixel:    center,
+			Lit:      lit,
+		}
+	}
+
+	gocv.AddWeighted(
+		r.display, float64(exposure)/displayExposure,
+		r.leds, float64(exposure)/ledExposure,
+		0, output,
+	)
+
+	return truth
+}
+
+func (r *renderer) Close() {
+	r.display.Close()
+	r.leds.Close()
+}
+
+func (c *Camera) pixel(point gocv.Point2f) image.Point {
+	p := c.Project(gocv.Point3f{X: point.X, Y: point.Y})
+	return image.Pt(int(p.X+0.5), int(p.Y+0.5))
+}
+
+func distance(a, b gocv.Point2f) float64 {
+	dx := float64(a.X - b.X)
+	dy := float64(a.Y - b.Y)
+	return math.Sqrt(dx*dx + dy*dy)
+}
@@ -0,0 +1,46 @@
+package synthetic
+
+import (
+	"math"
+	"time"
+
+	"gocv.io/x/gocv"
+)
+
+// Path returns the position of a token on the table at the given time since
+// the camera started
+type Path func(elapsed time.Duration) gocv.Point2f
+
+// Stationary keeps the token at a single point
+func Stationary(point gocv.Point2f) Path {
+	return func(time.Duration) gocv.Point2f {
+		return point
+	}
+}
+
+// Linear moves the token back and forth between from and to, taking duration
+// to travel in each direction
+func Linear(from, to gocv.Point2f, duration time.Duration) Path {
+	return func(elapsed time.Duration) gocv.Point2f {
+		progress := math.Mod(elapsed.Seconds()/duration.Seconds(), 2)
+		if progress > 1 {
+			progress = 2 - progress
+		}
+
+		return gocv.Point2f{
+			X: from.X + float32(progress)*(to.X-from.X),
+			Y: from.Y + float32(progress)*(to.Y-from.Y),
+		}
+	}
+}
+
+// Circular moves the token around a circle, completing a lap every period
+func Circular(center gocv.Point2f, radius float32, period time.Duration) Path {
+	return func(elapsed time.Duration) gocv.Point2f {
+		angle := 2 * math.Pi * elapsed.Seconds() / period.Seconds()
+		return gocv.Point2f{
+			X: center.X + radius*float32(math.Cos(angle)),
+			Y: center.Y + radius*float32(math.Sin(angle)),
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package synthetic
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"time"
+
+	"gocv.io/x/gocv"
+)
+
+// Scene describes the table and tokens that the synthetic camera renders. All
+// distances are in table units, the same units the app sends corner locations
+// in.
+type Scene struct {
+	// Width and Height of the table's display
+	Width  float32
+	Height float32
+
+	// CornerMarkerSize and CornerPadding place the four ArUco calibration
+	// markers the same way the app's calibration scene does
+	CornerMarkerSize float32
+	CornerPadding    float32
+
+	Tokens []Token
+	Camera CameraPose
+}
+
+// Token is a physical marker on the table with an LED that the tracker detects
+type Token struct {
+	ID   int
+	Path Path
+
+	// Radius of the LED's glow
+	Radius float32
+
+	// Color of the LED, red when unset
+	Color color.RGBA
+
+	// Height of the LED above the table, e.g. on the head of a miniature
+	Height float32
+
+	// Blink is the LED's state in each frame, repeated for as long as the
+	// camera runs, e.g. from tracker.EncodeTokenID. The LED is always on when
+	// empty.
+	Blink []bool
+}
+
+// CameraPose positions a pinhole camera above the table. With all angles at
+// zero the camera looks straight down with the top of the image towards the
+// top edge of the table.
+type CameraPose struct {
+	// X and Y of the camera over the table, Z is the height above the table
+	Position gocv.Point3f
+
+	// Rotations in degrees about the camera's optical axis (Yaw), image x
+	// axis (Pitch) and image y axis (Roll)
+	Yaw   float64
+	Pitch float64
+	Roll  float64
+
+	// FocalLength in pixels
+	FocalLength float64
+}
+
+// CornerMarker is one of the ArUco markers used for pose calibration
+type CornerMarker struct {
+	ID int
+
+	// Position on the table of the marker's first corner, which is the point
+	// the tracker uses for calibration
+	Position gocv.Point2f
+
+	// Corners of the marker on the table, in ArUco corner order
+	Corners [4]gocv.Point2f
+}
+
+// CornerMarkers returns the four calibration markers, numbered 1 to 4 starting
+// at the top left of the table and going clockwise
+func (s Scene) CornerMarkers() []CornerMarker {
+	positions := []gocv.Point2f{
+		{X: s.CornerPadding, Y: s.CornerPadding},
+		{X: s.Width - s.CornerPadding, Y: s.CornerPadding},
+		{X: s.Width - s.CornerPadding, Y: s.Height - s.CornerPadding},
+		{X: s.CornerPadding, Y: s.Height - s.CornerPadding},
+	}
+
+	markers := make([]CornerMarker, len(positions))
+	for i, position := range positions {
+		// Each marker is rotated a further 90 degrees about its first corner so
+		// that it sits inside the table
+		angle := float64(i) * math.Pi / 2
+		sin := float32(math.Sin(angle)) * s.CornerMarkerSize
+		cos := float32(math.Cos(angle)) * s.CornerMarkerSize
+
+		markers[i] = CornerMarker{
+			ID:       i + 1,
+			Position: position,
+			Corners: [4]gocv.Point2f{
+				position,
+				{X: position.X + cos, Y: position.Y + sin},
+				{X: position.X + cos - sin, Y: position.Y + sin + cos},
+				{X: position.X - sin, Y: position.Y + cos},
+			},
+		}
+	}
+	return markers
+}
+
+// DemoScene is a 40x22.5 table, about the size of a 46 inch display, laid out
+// like the app's calibration scene, with a few tokens moving around it. The
+// camera looks straight down with the table filling most of the frame.
+func DemoScene(resolution image.Point) Scene {
+	const width, height, cameraHeight = 40, 22.5, 40
+
+	return Scene{
+		Width:            width,
+		Height:           height,
+		CornerMarkerSize: 1,
+		CornerPadding:    0.1,
+		Tokens: []Token{
+			{ID: 1, Path: Stationary(gocv.Point2f{X: 10, Y: 6}), Radius: 0.3, Height: 0.5},
+			{ID: 2, Path: Linear(gocv.Point2f{X: 5, Y: 16}, gocv.Point2f{X: 35, Y: 16}, 10*time.Second), Radius: 0.3, Height: 0.5},
+			{ID: 3, Path: Circular(gocv.Point2f{X: 28, Y: 9}, 4, 8*time.Second), Radius: 0.3, Height: 0.5},
+		},
+		Camera: CameraPose{
+			Position:    gocv.Point3f{X: width / 2, Y: height / 2, Z: cameraHeight},
+			FocalLength: 0.9 * float64(resolution.X) * cameraHeight / width,
+		},
+	}
+}
+
+// rotation returns the matrix that rotates table directions into the camera's
+// frame. The table's z axis points down into the table.
+func (p CameraPose) rotation() [3][3]float64 {
+	yaw := p.Yaw * math.Pi / 180
+	pitch := p.Pitch * math.Pi / 180
+	roll := p.Roll * math.Pi / 180
+
+	rz := [3][3]float64{
+		{math.Cos(yaw), -math.Sin(yaw), 0},
+		{math.Sin(yaw), math.Cos(yaw), 0},
+		{0, 0, 1},
+	}
+	rx := [3][3]float64{
+		{1, 0, 0},
+		{0, math.Cos(pitch), -math.Sin(pitch)},
+		{0, math.Sin(pitch), math.Cos(pitch)},
+	}
+	ry := [3][3]float64{
+		{math.Cos(roll), 0, math.Sin(roll)},
+		{0, 1, 0},
+		{-math.Sin(roll), 0, math.Cos(roll)},
+	}
+
+	return multiply(multiply(rz, rx), ry)
+}
+
+func multiply(a, b [3][3]float64) [3][3]float64 {
+	var out [3][3]float64
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			for k := 0; k < 3; k++ {
+				out[i][j] += a[i][k] * b[k][j]
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,108 @@
+package tracker
+
+import "math"
+
+// assign solves the assignment problem for the cost matrix using the Hungarian
+// algorithm. It returns the column assigned to each row so that the total cost
+// is minimised. Each column is assigned to at most one row, and rows are only
+// left unassigned, as -1, when there are more rows than columns.
+func assign(cost [][]float64) []int {
+	rows := len(cost)
+	if rows == 0 {
+		return []int{}
+	}
+	cols := len(cost[0])
+
+	// The algorithm needs at least as many columns as rows
+	if rows > cols {
+		transposed := make([][]float64, cols)
+		for j := range transposed {
+			transposed[j] = make([]float64, rows)
+			for i := range cost {
+				transposed[j][i] = cost[i][j]
+			}
+		}
+
+		result := make([]int, rows)
+		for i := range result {
+			result[i] = -1
+		}
+		for j, i := range assign(transposed) {
+			if i >= 0 {
+				result[i] = j
+			}
+		}
+		return result
+	}
+
+	// Potentials of the rows and columns, and the row assigned to each column,
+	// all indexed from 1 so that 0 can stand for none
+	u := make([]float64, rows+1)
+	v := make([]float64, cols+1)
+	p := make([]int, cols+1)
+	way := make([]int, cols+1)
+
+	for i := 1; i <= rows; i++ {
+		p[0] = i
+		j0 := 0
+		minv := make([]float64, cols+1)
+		for j := range minv {
+			minv[j] = math.Inf(1)
+		}
+		used := make([]bool, cols+1)
+
+		// Grow an alternating path from row i until it reaches a free column
+		for {
+			used[j0] = true
+			i0 := p[j0]
+			delta := math.Inf(1)
+			j1 := 0
+			for j := 1; j <= cols; j++ {
+				if used[j] {
+					continue
+				}
+				reduced := cost[i0-1][j-1] - u[i0] - v[j]
+				if reduced < minv[j] {
+					minv[j] = reduced
+					way[j] = j0
+				}
+				if minv[j] < delta {
+					delta = minv[j]
+					j1 = j
+				}
+			}
+
+			for j := 0; j <= cols; j++ {
+				if used[j] {
+					u[p[j]] += delta
+					v[j] -= delta
+				} else {
+					minv[j] -= delta
+				}
+			}
+
+			j0 = j1
+			if p[j0] == 0 {
+				break
+			}
+		}
+
+		// Flip the path so row i is assigned
+		for j0 != 0 {
+			j1 := way[j0]
+			p[j0] = p[j1]
+			j0 = j1
+		}
+	}
+
+	result := make([]int, rows)
+	for i := range result {
+		result[i] = -1
+	}
+	for j := 1; j <= cols; j++ {
+		if p[j] != 0 {
+			result[p[j]-1] = j - 1
+		}
+	}
+	return result
+}
@@ -0,0 +1,175 @@
+package tracker
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+// minimumCost finds the cheapest assignment by trying every one
+func minimumCost(cost [][]float64, row int, used []bool) float64 {
+	if row == len(cost) {
+		return 0
+	}
+
+	// Rows can only be left unassigned if there are more rows than columns
+	best := math.Inf(1)
+	if len(cost)-row > countUnused(used) {
+		best = minimumCost(cost, row+1, used)
+	}
+	for j := range used {
+		if used[j] {
+			continue
+		}
+		used[j] = true
+		best = min(best, cost[row][j]+minimumCost(cost, row+1, used))
+		used[j] = false
+	}
+	return best
+}
+
+func countUnused(used []bool) int {
+	unused := 0
+	for _, u := range used {
+		if !u {
+			unused++
+		}
+	}
+	return unused
+}
+
+func TestAssign(t *testing.T) {
+	const x = unassignableCost
+
+	tests := []struct {
+		name     string
+		cost     [][]float64
+		expected []int
+	}{
+		{
+			name:     "empty",
+			cost:     [][]float64{},
+			expected: []int{},
+		},
+		{
+			name:     "single",
+			cost:     [][]float64{{5}},
+			expected: []int{0},
+		},
+		{
+			name: "square",
+			cost: [][]float64{
+				{4, 1, 3},
+				{2, 0, 5},
+				{3, 2, 2},
+			},
+			expected: []int{1, 0, 2},
+		},
+		{
+			name: "square greedy is wrong",
+			cost: [][]float64{
+				{1, 2},
+				{2, 10},
+			},
+			expected: []int{1, 0},
+		},
+		{
+			name: "more columns",
+			cost: [][]float64{
+				{9, 1, 7, 8},
+				{2, 6, 1, 9},
+			},
+			expected: []int{1, 2},
+		},
+		{
+			name: "more rows",
+			cost: [][]float64{
+				{9, 1},
+				{1, 9},
+				{0.5, 2},
+				{4, 0.5},
+			},
+			expected: []int{-1, -1, 0, 1},
+		},
+		{
+			name: "single column",
+			cost: [][]float64{
+				{3},
+				{1},
+				{2},
+			},
+			expected: []int{-1, 0, -1},
+		},
+		{
+			name: "partially gated",
+			cost: [][]float64{
+				{1, x, x},
+				{x, x, 2},
+				{x, 3, x},
+			},
+			expected: []int{0, 2, 1},
+		},
+		{
+			name: "gated forces second best",
+			cost: [][]float64{
+				{1, 2},
+				{x, 5},
+				{x, x},
+			},
+			expected: []int{0, 1, -1},
+		},
+		{
+			name: "fully gated",
+			cost: [][]float64{
+				{x, x},
+				{x, x},
+			},
+		},
+		{
+			name: "fully gated with more rows",
+			cost: [][]float64{
+				{x, x},
+				{x, x},
+				{x, x},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := assign(test.cost)
+			if len(result) != len(test.cost) {
+				t.Fatalf("got %d assignments for %d rows", len(result), len(test.cost))
+			}
+			if test.expected != nil && !slices.Equal(result, test.expected) {
+				t.Errorf("got %v, expected %v", result, test.expected)
+			}
+
+			cols := 0
+			if len(test.cost) > 0 {
+				cols = len(test.cost[0])
+			}
+			assigned := make([]bool, cols)
+			total := 0.0
+			for i, j := range result {
+				if j < 0 {
+					continue
+				}
+				if assigned[j] {
+					t.Errorf("column %d is assigned more than once in %v", j, result)
+				}
+				assigned[j] = true
+				total += test.cost[i][j]
+			}
+
+			// Every row or every column is assigned, whichever there are fewer of
+			if countUnused(assigned) != cols-min(cols, len(test.cost)) {
+				t.Errorf("only %d of %d columns are assigned in %v", cols-countUnused(assigned), min(cols, len(test.cost)), result)
+			}
+
+			if optimal := minimumCost(test.cost, 0, make([]bool, cols)); total != optimal {
+				t.Errorf("total cost is %v, expected %v", total, optimal)
+			}
+		})
+	}
+}
@@ -25,19 +25,22 @@ func (t *Tracker) CalibrateExposure(ctx context.Context, pixelCountTarget int, d
 	}
 	exposure := 15000
 	ticker := time.NewTicker(loopRate)
-	red := gocv.NewMat()
+	masks := newColorMasks()
+	defer masks.close()
+	frame := gocv.NewMat()
+	defer frame.Close()
 	for {
 		select {
 		case <-ctx.Done():
 			ticker.Stop()
 			return -1
 		case <-ticker.C:
-			imgs := gocv.Split(t.frame)
-			gocv.Threshold(imgs[2], &red, thresholdMinimum, 255, gocv.ThresholdBinary)
-			for _, img := range imgs {
-				img.Close()
+			t.copyFrame(&frame)
+			if frame.Empty() {
+				continue
 			}
-			nonZeros := gocv.CountNonZero(red)
+			masks.threshold(frame)
+			nonZeros := gocv.CountNonZero(masks.combined)
 
 			controller.Update(pid.ControllerInput{
 				ReferenceSignal:  float64(pixelCountTarget),
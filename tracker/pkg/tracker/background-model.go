@@ -0,0 +1,141 @@
+package tracker
+
+import (
+	"image"
+	"image/color"
+
+	"gocv.io/x/gocv"
+)
+
+// DetectionParameters tune how LEDs are picked out of the frames while
+// tracking, and can be changed while tracking
+type DetectionParameters struct {
+	// MinBrightness is the lowest brightness, from 0 to 255, an LED's pixels
+	// can have
+	MinBrightness float64
+
+	// Pixels have to be AdaptiveOffset brighter than the mean of the
+	// AdaptiveBlockSize pixels square around them, so that a lamp being turned
+	// on or the TV brightening doesn't light up whole areas of the table
+	AdaptiveOffset    float64
+	AdaptiveBlockSize int
+
+	// The background model learns what the scene looks like without markers,
+	// taking in BackgroundLearningRate of each frame, and pixels have to be
+	// ForegroundThreshold brighter than it. A learning rate of 0 disables it.
+	BackgroundLearningRate float64
+	ForegroundThreshold    float64
+}
+
+// DefaultDetectionParameters are used until the tracker is told otherwise
+var DefaultDetectionParameters = DetectionParameters{
+	MinBrightness:          120,
+	AdaptiveOffset:         40,
+	AdaptiveBlockSize:      41,
+	BackgroundLearningRate: 0.02,
+	ForegroundThreshold:    40,
+}
+
+const (
+	// The background isn't learned within this many pixels of a marker, so
+	// tokens that stay still don't fade into it
+	backgroundMarkerRadius = 15
+
+	// The background starts as the first frame blurred this much, so LEDs that
+	// are already on the table aren't taken for part of it
+	backgroundInitialBlur = 51
+)
+
+// backgroundModel is a running average of the brightness of the scene
+type backgroundModel struct {
+	background gocv.Mat
+	resets     int
+
+	valueFloat gocv.Mat
+	difference gocv.Mat
+	adaptive   gocv.Mat
+	foreground gocv.Mat
+	learnMask  gocv.Mat
+}
+
+func newBackgroundModel() *backgroundModel {
+	return &backgroundModel{
+		background: gocv.NewMat(),
+		valueFloat: gocv.NewMat(),
+		difference: gocv.NewMat(),
+		adaptive:   gocv.NewMat(),
+		foreground: gocv.NewMat(),
+		learnMask:  gocv.NewMat(),
+	}
+}
+
+func (b *backgroundModel) close() {
+	b.background.Close()
+	b.valueFloat.Close()
+	b.difference.Close()
+	b.adaptive.Close()
+	b.foreground.Close()
+	b.learnMask.Close()
+}
+
+// SetDetectionParameters changes how LEDs are picked out of the frames. The
+// learned background is forgotten if resetBackground is set, e.g. after the
+// camera has been moved.
+func (t *Tracker) SetDetectionParameters(params DetectionParameters, resetBackground bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.detectionParameters = params
+	if resetBackground {
+		t.backgroundResets++
+	}
+}
+
+// GetDetectionParameters returns how LEDs are picked out of the frames
+func (t *Tracker) GetDetectionParameters() DetectionParameters {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.detectionParameters
+}
+
+// brightPixels flags the pixels of the value channel that could be part of an
+// LED in bright, and learns the scene from everything away from the markers
+func (t *Tracker) brightPixels(background *backgroundModel, value gocv.Mat, markers []*Marker, bright *gocv.Mat) {
+	t.mutex.RLock()
+	params := t.detectionParameters
+	resets := t.backgroundResets
+	t.mutex.RUnlock()
+
+	gocv.Threshold(value, bright, float32(params.MinBrightness)-1, 255, gocv.ThresholdBinary)
+
+	if params.AdaptiveBlockSize > 1 {
+		// The block has to be an odd size
+		blockSize := params.AdaptiveBlockSize | 1
+		gocv.AdaptiveThreshold(value, &background.adaptive, 255, gocv.AdaptiveThresholdMean, gocv.ThresholdBinary, blockSize, -float32(params.AdaptiveOffset))
+		gocv.BitwiseAnd(*bright, background.adaptive, bright)
+	}
+
+	if params.BackgroundLearningRate <= 0 {
+		return
+	}
+
+	value.ConvertTo(&background.valueFloat, gocv.MatTypeCV32F)
+	if background.background.Empty() || background.background.Rows() != value.Rows() || background.background.Cols() != value.Cols() || background.resets != resets {
+		gocv.Blur(background.valueFloat, &background.background, image.Pt(backgroundInitialBlur, backgroundInitialBlur))
+		background.resets = resets
+	}
+
+	gocv.Subtract(background.valueFloat, background.background, &background.difference)
+	gocv.Threshold(background.difference, &background.difference, float32(params.ForegroundThreshold), 255, gocv.ThresholdBinary)
+	background.difference.ConvertTo(&background.foreground, gocv.MatTypeCV8U)
+	gocv.BitwiseAnd(*bright, background.foreground, bright)
+
+	background.learnMask.Close()
+	background.learnMask = gocv.NewMatWithSizeFromScalar(gocv.NewScalar(255, 0, 0, 0), value.Rows(), value.Cols(), gocv.MatTypeCV8U)
+	for _, marker := range markers {
+		position := image.Pt(int(marker.Position.X+0.5), int(marker.Position.Y+0.5))
+		gocv.Circle(&background.learnMask, position, backgroundMarkerRadius, color.RGBA{}, -1)
+	}
+	gocv.AccumulatedWeightedWithMask(background.valueFloat, &background.background, params.BackgroundLearningRate, background.learnMask)
+}
@@ -0,0 +1,203 @@
+package tracker
+
+import (
+	"math"
+	"slices"
+	"time"
+)
+
+const (
+	// TokenIDBits is the number of bits in a blink coded token ID
+	TokenIDBits = 6
+
+	// TokenKeyOffset is added to decoded token IDs when keying markers, so they
+	// never collide with the identifiers handed out by the MarkerSet
+	TokenKeyOffset = 256
+
+	// A token ID has to be decoded this many times in a row before it is
+	// trusted
+	blinkConfirmations = 2
+
+	// BlinkCodeLength is the number of frames each repetition of a code lasts,
+	// the sync pattern followed by the Manchester encoded ID and parity bit
+	BlinkCodeLength = 6 + 2*(TokenIDBits+1)
+
+	// Samples are kept for this many codes, enough to measure the bit period
+	// and to decode the latest code
+	blinkHistory = 2 * BlinkCodeLength
+)
+
+// Each code starts with the LED on for four frames and off for two. The ID and
+// an even parity bit follow, most significant bit first, Manchester encoded
+// so the LED is never on or off for more than two frames in a row. The sync
+// pattern can therefore never appear within the data.
+var blinkSync = []bool{true, true, true, true, false, false}
+
+// EncodeTokenID returns the LED state for each frame of the token's code. The
+// token repeats it continuously, changing state once per camera frame.
+func EncodeTokenID(id int) []bool {
+	code := append([]bool{}, blinkSync...)
+
+	parity := false
+	for i := TokenIDBits - 1; i >= 0; i-- {
+		bit := id&(1<<i) != 0
+		parity = parity != bit
+		code = append(code, bit, !bit)
+	}
+	code = append(code, parity, !parity)
+
+	return code
+}
+
+type blinkSample struct {
+	at time.Time
+	on bool
+}
+
+// blinkSlot is the LED's state during one bit period of a code, unknown when
+// the frame covering it was dropped
+type blinkSlot struct {
+	known bool
+	on    bool
+}
+
+// blinkDecoder decodes a token ID from whether the marker's LED was seen in
+// each frame. Frames are placed in the code by their timestamps rather than
+// counted, so a dropped frame leaves a gap instead of shifting the rest of the
+// code.
+type blinkDecoder struct {
+	samples       []blinkSample
+	lastID        int
+	confirmations int
+	lastDecodedAt time.Time
+}
+
+// sample adds whether the LED was on in the frame at the given time, and
+// returns the token ID once it has been confirmed
+func (d *blinkDecoder) sample(on bool, at time.Time) (int, bool) {
+	d.samples = append(d.samples, blinkSample{at: at, on: on})
+	if len(d.samples) > blinkHistory {
+		d.samples = d.samples[len(d.samples)-blinkHistory:]
+	}
+	if len(d.samples) < BlinkCodeLength {
+		return 0, false
+	}
+
+	period := d.bitPeriod()
+	if period <= 0 {
+		return 0, false
+	}
+
+	// Only a code that has just finished is decoded, so each repetition is
+	// counted once
+	if !d.lastDecodedAt.IsZero() && at.Sub(d.lastDecodedAt) < period*BlinkCodeLength/2 {
+		return 0, false
+	}
+
+	slots := make([]blinkSlot, BlinkCodeLength)
+	for _, s := range d.samples {
+		slot := BlinkCodeLength - 1 - int(math.Round(float64(at.Sub(s.at))/float64(period)))
+		if slot >= 0 && slot < BlinkCodeLength {
+			slots[slot] = blinkSlot{known: true, on: s.on}
+		}
+	}
+
+	id, ok := decodeBlinkCode(slots)
+	if !ok {
+		return 0, false
+	}
+	d.lastDecodedAt = at
+
+	if d.confirmations > 0 && id == d.lastID {
+		d.confirmations++
+	} else {
+		d.lastID = id
+		d.confirmations = 1
+	}
+
+	return id, d.confirmations >= blinkConfirmations
+}
+
+// bitPeriod estimates how long the LED holds each state as the average time
+// between frames. Intervals well above the median are left out, as they span
+// dropped frames.
+func (d *blinkDecoder) bitPeriod() time.Duration {
+	intervals := make([]time.Duration, 0, len(d.samples)-1)
+	for i := 1; i < len(d.samples); i++ {
+		intervals = append(intervals, d.samples[i].at.Sub(d.samples[i-1].at))
+	}
+	slices.Sort(intervals)
+	median := intervals[len(intervals)/2]
+
+	var total time.Duration
+	count := 0
+	for _, interval := range intervals {
+		if interval < median*3/2 {
+			total += interval
+			count++
+		}
+	}
+	if count == 0 {
+		return 0
+	}
+	return total / time.Duration(count)
+}
+
+// decodeBlinkCode decodes one repetition of a code. A slot that is unknown can
+// be made up for by the rest of the sync pattern, or by the other half of its
+// Manchester encoded bit.
+func decodeBlinkCode(slots []blinkSlot) (int, bool) {
+	unknown := 0
+	for i, on := range blinkSync {
+		if !slots[i].known {
+			unknown++
+			continue
+		}
+		if slots[i].on != on {
+			return 0, false
+		}
+	}
+	if unknown > 1 {
+		return 0, false
+	}
+
+	data := slots[len(blinkSync):]
+	id := 0
+	parity := false
+	for i := 0; i <= TokenIDBits; i++ {
+		first, second := data[2*i], data[2*i+1]
+		var bit bool
+		switch {
+		case first.known && second.known:
+			if first.on == second.on {
+				return 0, false
+			}
+			bit = first.on
+		case first.known:
+			bit = first.on
+		case second.known:
+			bit = !second.on
+		default:
+			return 0, false
+		}
+		parity = parity != bit
+
+		if i < TokenIDBits {
+			id = id<<1 | boolToInt(bit)
+		}
+	}
+
+	// The parity bit itself was included, so the total is even when valid
+	if parity {
+		return 0, false
+	}
+
+	return id, true
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
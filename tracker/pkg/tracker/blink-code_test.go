@@ -0,0 +1,77 @@
+package tracker
+
+import (
+	"testing"
+	"time"
+)
+
+const testBitPeriod = 33 * time.Millisecond
+
+// decodeBlinks feeds the token's code, repeated, through a decoder one frame
+// per bit period. Frames whose index is in dropped are never sampled. It
+// returns the first confirmed ID, and how many frames it took.
+func decodeBlinks(t *testing.T, id int, repetitions int, dropped map[int]bool) (int, int, bool) {
+	t.Helper()
+
+	code := EncodeTokenID(id)
+	start := time.Now()
+	decoder := blinkDecoder{}
+	for frame := 0; frame < repetitions*len(code); frame++ {
+		if dropped[frame] {
+			continue
+		}
+
+		// A little jitter, as frames are timestamped when they are processed
+		jitter := time.Duration(frame%3-1) * 3 * time.Millisecond
+		at := start.Add(time.Duration(frame)*testBitPeriod + jitter)
+
+		decoded, ok := decoder.sample(code[frame%len(code)], at)
+		if ok {
+			return decoded, frame, true
+		}
+	}
+	return 0, 0, false
+}
+
+func TestBlinkCodeRoundTrip(t *testing.T) {
+	for id := 0; id < 1<<TokenIDBits; id++ {
+		decoded, frame, ok := decodeBlinks(t, id, 3, nil)
+		if !ok {
+			t.Errorf("token %d wasn't decoded", id)
+			continue
+		}
+		if decoded != id {
+			t.Errorf("token %d was decoded as %d", id, decoded)
+		}
+		if frame != blinkConfirmations*BlinkCodeLength-1 {
+			t.Errorf("token %d took until frame %d to be confirmed", id, frame)
+		}
+	}
+}
+
+func TestBlinkCodeDroppedFrames(t *testing.T) {
+	// One frame from each part of the code: the sync pattern, the first half
+	// of a bit and the second half of another
+	for _, dropped := range []int{2, BlinkCodeLength + 8, BlinkCodeLength + 11} {
+		for _, id := range []int{1, 21, 42, 63} {
+			decoded, _, ok := decodeBlinks(t, id, 3, map[int]bool{dropped: true})
+			if !ok {
+				t.Errorf("token %d wasn't decoded with frame %d dropped", id, dropped)
+			} else if decoded != id {
+				t.Errorf("token %d was decoded as %d with frame %d dropped", id, decoded, dropped)
+			}
+		}
+	}
+}
+
+func TestBlinkCodeRejectsNoise(t *testing.T) {
+	start := time.Now()
+	decoder := blinkDecoder{}
+
+	// A steady LED isn't a code
+	for frame := 0; frame < 4*BlinkCodeLength; frame++ {
+		if id, ok := decoder.sample(true, start.Add(time.Duration(frame)*testBitPeriod)); ok {
+			t.Fatalf("steady LED was decoded as token %d", id)
+		}
+	}
+}
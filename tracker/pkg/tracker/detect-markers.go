@@ -4,17 +4,84 @@ import (
 	"context"
 	"fmt"
 	"image"
+	"math"
 	"time"
 
 	"gocv.io/x/gocv"
 )
 
+// detection is a blob found in a single frame
+type detection struct {
+	position    gocv.Point2f
+	color       MarkerColor
+	area        float64
+	circularity float64
+	brightness  float64
+
+	// Which way the detection faces, in radians, unless headingSource is none
+	heading           float64
+	headingConfidence float64
+	headingSource     HeadingSource
+}
+
+const (
+	// MaxAssociationDistance is how far, in table units, a detection can be
+	// from where a marker is expected to be and still be considered the same
+	// marker
+	MaxAssociationDistance = 2.0
+
+	// MaxRecoveryDistance is how far, in table units, a detection can be from
+	// where a lost marker was last seen and still be considered that marker
+	MaxRecoveryDistance = 3.0
+
+	// The same gates in pixels, used before the pose has been calibrated
+	maxAssociationPixels = 100
+	maxRecoveryPixels    = 150
+
+	// Markers that haven't been seen for this long are lost
+	markerLostTimeout = 500 * time.Millisecond
+
+	// MinMarkerAge is how long a marker has to be seen before it appears to
+	// clients, so that flickers of stray light are ignored
+	MinMarkerAge = 100 * time.Millisecond
+
+	// MarkerMoveThreshold is how far, in table units, a marker has to move
+	// before a moved event is sent
+	MarkerMoveThreshold = 0.25
+
+	// The same threshold in pixels, used before the pose has been calibrated
+	markerMovePixels = 5
+
+	// DefaultLostGracePeriod is how long lost markers are kept before they are
+	// removed, unless the tracker is told otherwise
+	DefaultLostGracePeriod = 5 * time.Second
+
+	// Blobs smaller than this many pixels are noise
+	minMarkerArea = 10
+
+	// Blobs less circular than this are reflections or stray light rather
+	// than an LED
+	MinMarkerCircularity = 0.5
+
+	// Cost of pairs outside the gate, large enough that they are only assigned
+	// when nothing else is possible, in which case they are discarded
+	unassignableCost = 1e9
+)
+
 func (t *Tracker) DetectMarkers(ctx context.Context) {
 	frameListener, deregister := t.registerFrameListener()
 
 	fmt.Println("Detecting markers")
-	red := gocv.NewMat()
-	t.debugFrames["markers"] = red
+	masks := newColorMasks()
+	defer masks.close()
+	roi := newTableROI()
+	defer roi.close()
+	background := newBackgroundModel()
+	defer background.close()
+	backgroundFrame := gocv.NewMat()
+	defer backgroundFrame.Close()
+	frame := gocv.NewMat()
+	defer frame.Close()
 
 	t.SetExposure(1000)
 
@@ -24,60 +91,314 @@ func (t *Tracker) DetectMarkers(ctx context.Context) {
 			fmt.Println("Done detecting markers")
 			deregister()
 			return
-		case f := <-frameListener:
+		case <-frameListener:
 			if t.camera.GetExposure() != 1000 {
 				t.SetExposure(1000)
 			}
 			frameTime := time.Now()
-			gocv.ExtractChannel(f, &red, 2)
-			gocv.Threshold(red, &red, thresholdMinimum, 255, gocv.ThresholdBinary)
+			t.copyFrame(&frame)
+			if frame.Empty() {
+				continue
+			}
+			masks.convert(frame)
+			t.brightPixels(background, masks.value, t.markers.GetMarkers(), &masks.bright)
+			masks.split()
+			if !background.background.Empty() {
+				background.background.ConvertTo(&backgroundFrame, gocv.MatTypeCV8U)
+				t.publishDebugFrame("background", backgroundFrame)
+			}
+			if t.refreshTableROI(roi, image.Pt(frame.Cols(), frame.Rows())) {
+				gocv.BitwiseAnd(masks.combined, roi.mask, &masks.combined)
+			}
+			t.publishDebugFrame("markers", masks.combined)
 
 			// Detect markers in frame
-			contours := gocv.FindContours(red, gocv.RetrievalExternal, gocv.ChainApproxSimple)
+			contours := gocv.FindContours(masks.combined, gocv.RetrievalExternal, gocv.ChainApproxSimple)
 
-			for _, marker := range t.markers.GetMarkers() {
-				if (marker.LastSeen.Add(500 * time.Millisecond)).Before(frameTime) {
-					t.markers.RemoveMarker(marker.Identifier)
-				}
-			}
+			// Hold the marker set for the whole frame so readers never see it
+			// part way through an update
+			t.markers.mutex.Lock()
+			t.expireMarkers(frameTime)
 
-			// For each marker, find the closes existing marker in the marker set, otherwise create one
+			detections := []detection{}
 			for i := 0; i < contours.Size(); i++ {
 				contour := contours.At(i)
 
-				area := gocv.ContourArea(contour)
-				if area < 10 {
+				d, ok := measureBlob(masks, contour)
+				if !ok {
+					continue
+				}
+				detections = append(detections, d)
+			}
+
+			detections = t.clipDetections(detections)
+			detections = t.excludeDetections(detections)
+			t.associateDetections(detections, frameTime)
+
+			// Every marker's LED was either seen or not this frame, which is
+			// what blink coded tokens are identified by
+			for _, marker := range t.markers.all() {
+				if marker.Lost {
 					continue
 				}
 
-				rect := gocv.BoundingRect(contour)
-				center := image.Point{X: rect.Min.X + rect.Dx()/2, Y: rect.Min.Y + rect.Dy()/2}
-
-				// Find the closest marker in the marker set
-				closestMarker := t.markers.FindClosestMarker(center, 20000) // TODO: this number is made up
-
-				// If the closest marker is within a threshold, update the position
-				if closestMarker != nil {
-					// fmt.Println("Updating marker", closestMarker.Identifier, "from", closestMarker.Position, "to", center)
-					closestMarker.Position = center
-					closestMarker.LastSeen = frameTime
-				} else {
-					fmt.Println("Found new marker:", center, t.ConvertPixelTo3D(center), area)
-					// Otherwise, add a new marker
-					marker := Marker{
-						Position:  center,
-						FirstSeen: frameTime,
-						LastSeen:  frameTime,
-					}
-					t.markers.AddMarker(marker)
+				tokenID, ok := marker.decoder.sample(marker.LastSeen.Equal(frameTime), frameTime)
+				if ok && tokenID != marker.TokenID {
+					fmt.Println("Marker", marker.Identifier, "is token", tokenID)
+					t.setTokenID(marker, tokenID, frameTime)
 				}
 			}
 
+			t.pairLEDs()
+			t.updateMarkerLifecycles(frameTime)
+			t.markers.mutex.Unlock()
+
 			contours.Close()
+		}
+	}
+}
+
+// expireMarkers loses markers that haven't been seen for a moment and removes
+// lost markers whose grace period is over. The rest are predicted forward to
+// the frame, so detections are associated with where markers are expected to be
+// now and fast moving markers aren't mistaken for new ones.
+func (t *Tracker) expireMarkers(frameTime time.Time) {
+	t.mutex.RLock()
+	lostGracePeriod := t.lostGracePeriod
+	t.mutex.RUnlock()
+
+	for _, marker := range t.markers.all() {
+		if marker.Lost {
+			if marker.LostAt.Add(lostGracePeriod).Before(frameTime) {
+				t.markers.remove(marker.Identifier)
+				t.emitMarkerEvent(MarkerRemoved, marker, frameTime)
+			}
+			continue
+		}
+
+		if marker.LastSeen.Add(markerLostTimeout).Before(frameTime) {
+			// Markers that never appeared were most likely noise
+			if !marker.Appeared {
+				t.markers.remove(marker.Identifier)
+				continue
+			}
+
+			marker.lose(frameTime)
+			t.emitMarkerEvent(MarkerLost, marker, frameTime)
+			continue
+		}
+
+		marker.predict(frameTime)
+	}
+}
+
+// updateMarkerLifecycles sends appeared events for markers that have been seen
+// for long enough, and moved events for markers that have moved far enough
+// since they were last reported
+func (t *Tracker) updateMarkerLifecycles(frameTime time.Time) {
+	for _, marker := range t.markers.all() {
+		if marker.Lost || !marker.LastSeen.Equal(frameTime) {
+			continue
+		}
+
+		if !marker.Appeared {
+			if marker.LastSeen.Sub(marker.FirstSeen) >= MinMarkerAge {
+				marker.Appeared = true
+				marker.reported = marker.Position
+				t.emitMarkerEvent(MarkerAppeared, marker, frameTime)
+			}
+			continue
+		}
+
+		points := t.associationSpace([]gocv.Point2f{marker.reported, marker.Position})
+		if distance(points[0], points[1]) > t.moveThreshold() {
+			marker.reported = marker.Position
+			t.emitMarkerEvent(MarkerMoved, marker, frameTime)
+		}
+	}
+}
+
+// setTokenID gives the marker its decoded token ID. Clients know markers by
+// their key, which changes with the token ID, so any marker whose key changes
+// is reported as removed under its old key and appeared under its new one.
+func (t *Tracker) setTokenID(marker *Marker, tokenID int, frameTime time.Time) {
+	previous := make(map[*Marker]int)
+	for _, m := range t.markers.all() {
+		previous[m] = m.TokenID
+	}
 
-			for _, listener := range t.markerListeners {
-				listener <- t.markers.GetMarkers()
+	t.markers.setTokenID(marker.Identifier, tokenID)
+
+	for m, previousTokenID := range previous {
+		if !m.Appeared || m.TokenID == previousTokenID {
+			continue
+		}
+
+		old := *m
+		old.TokenID = previousTokenID
+		t.emitMarkerEvent(MarkerRemoved, &old, frameTime)
+		t.emitMarkerEvent(MarkerAppeared, m, frameTime)
+	}
+}
+
+func (t *Tracker) moveThreshold() float64 {
+	if !t.isPoseCalibrated() {
+		return markerMovePixels
+	}
+	return MarkerMoveThreshold
+}
+
+// measureBlob finds the centroid of the blob, weighted by the brightness of its
+// pixels so it is accurate to a fraction of a pixel, along with its shape. Blobs
+// that are too small or not round enough to be an LED are rejected.
+func measureBlob(masks *colorMasks, contour gocv.PointVector) (detection, bool) {
+	area := gocv.ContourArea(contour)
+	if area < minMarkerArea {
+		return detection{}, false
+	}
+
+	perimeter := gocv.ArcLength(contour, true)
+	circularity := 0.0
+	if perimeter > 0 {
+		circularity = 4 * math.Pi * area / (perimeter * perimeter)
+	}
+	if circularity < MinMarkerCircularity {
+		return detection{}, false
+	}
+
+	rect := gocv.BoundingRect(contour)
+	value := masks.value.Region(rect)
+	defer value.Close()
+	mask := masks.combined.Region(rect)
+	defer mask.Close()
+
+	weighted := gocv.NewMat()
+	defer weighted.Close()
+	gocv.BitwiseAnd(value, mask, &weighted)
+
+	moments := gocv.Moments(weighted, false)
+	pixels := gocv.CountNonZero(mask)
+	if moments["m00"] == 0 || pixels == 0 {
+		return detection{}, false
+	}
+
+	d := detection{
+		position: gocv.Point2f{
+			X: float32(float64(rect.Min.X) + moments["m10"]/moments["m00"]),
+			Y: float32(float64(rect.Min.Y) + moments["m01"]/moments["m00"]),
+		},
+		color:       masks.classify(rect),
+		area:        area,
+		circularity: circularity,
+		brightness:  moments["m00"] / float64(pixels),
+	}
+
+	axis, elongation := blobElongation(moments)
+	if elongation >= minHeadingElongation {
+		d.heading = axis
+		d.headingConfidence = math.Min(1, elongation/fullConfidenceElongation)
+		d.headingSource = HeadingSourceElongation
+	}
+
+	return d, true
+}
+
+// associateDetections matches the detections to the existing markers so that
+// the total distance between them is smallest. Detections further than the
+// gate from any marker become new markers, unless the table is displaying
+// something like them there, and markers without a detection keep their
+// predicted position until they are lost. Lost markers are gated more loosely,
+// as whatever hid them may also have moved them.
+func (t *Tracker) associateDetections(detections []detection, frameTime time.Time) {
+	markers := t.markers.all()
+
+	markerPositions := make([]gocv.Point2f, len(markers))
+	for j, marker := range markers {
+		markerPositions[j] = marker.Position
+	}
+	detectionPositions := make([]gocv.Point2f, len(detections))
+	for i, detection := range detections {
+		detectionPositions[i] = detection.position
+	}
+	detectionPoints := t.associationSpace(detectionPositions)
+	markerPoints := t.associationSpace(markerPositions)
+	gates := make([]float64, len(markers))
+	for j, marker := range markers {
+		gates[j] = t.associationGate(marker.Lost)
+	}
+
+	assignments := []int{}
+	if len(markers) > 0 {
+		cost := make([][]float64, len(detections))
+		for i := range detections {
+			cost[i] = make([]float64, len(markers))
+			for j := range markers {
+				cost[i][j] = distance(detectionPoints[i], markerPoints[j])
+				if cost[i][j] > gates[j] || !markers[j].matchesColor(detections[i].color) {
+					cost[i][j] = unassignableCost
+				}
 			}
 		}
+		assignments = assign(cost)
+	}
+
+	for i, detection := range detections {
+		if i < len(assignments) && assignments[i] >= 0 {
+			j := assignments[i]
+			if distance(detectionPoints[i], markerPoints[j]) <= gates[j] && markers[j].matchesColor(detection.color) {
+				recovered := markers[j].Lost
+				markers[j].observe(detection, frameTime)
+				if recovered {
+					t.emitMarkerEvent(MarkerRecovered, markers[j], frameTime)
+				}
+				continue
+			}
+		}
+
+		if t.isDisplayed(detection) {
+			continue
+		}
+
+		fmt.Println("Found new", detection.color, "marker:", detection.position, t.ConvertPixelTo3D(detection.position))
+		_, err := t.markers.add(newMarker(detection, frameTime))
+		if err != nil {
+			fmt.Println("Ignoring new marker:", err)
+		}
 	}
 }
+
+// associationSpace maps pixels onto the table so they can be gated in table
+// units, or leaves them as pixels if the tracker hasn't been calibrated
+func (t *Tracker) associationSpace(pixels []gocv.Point2f) []gocv.Point2f {
+	calibrated := t.isPoseCalibrated()
+	points := make([]gocv.Point2f, len(pixels))
+	for i, pixel := range pixels {
+		if !calibrated {
+			points[i] = pixel
+		} else {
+			real := t.ConvertPixelTo3D(pixel)
+			points[i] = gocv.Point2f{X: real.X, Y: real.Y}
+		}
+	}
+	return points
+}
+
+func (t *Tracker) associationGate(lost bool) float64 {
+	if !t.isPoseCalibrated() {
+		if lost {
+			return maxRecoveryPixels
+		}
+		return maxAssociationPixels
+	}
+
+	if lost {
+		return MaxRecoveryDistance
+	}
+	return MaxAssociationDistance
+}
+
+func distance(a, b gocv.Point2f) float64 {
+	dx := float64(a.X - b.X)
+	dy := float64(a.Y - b.Y)
+	return math.Sqrt(dx*dx + dy*dy)
+}
@@ -0,0 +1,152 @@
+package tracker
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"gocv.io/x/gocv"
+)
+
+const (
+	// TokenTagDictionary is the family of ArUco tags printed on token bases.
+	// Its 4x4 tags are the easiest to read when they are only a few pixels
+	// across.
+	TokenTagDictionary = gocv.ArucoDict4x4_50
+
+	// Tags are printed, not lit, so need the exposure of a normal picture
+	tagExposure = 15000
+)
+
+// DetectTags tracks tokens by the ArUco tags on their bases until the context
+// is cancelled. Each tag's ID is its token ID, so tokens keep their key
+// wherever they are seen, and the tag's top edge gives the token's heading.
+func (t *Tracker) DetectTags(ctx context.Context) {
+	frameListener, deregister := t.registerFrameListener()
+
+	fmt.Println("Detecting tags")
+	frame := gocv.NewMat()
+	defer frame.Close()
+	gray := gocv.NewMat()
+	defer gray.Close()
+	output := gocv.NewMat()
+	defer output.Close()
+
+	params := gocv.NewArucoDetectorParameters()
+	params.SetCornerRefinementMethod(1) // sub-pixel
+	dict := gocv.GetPredefinedDictionary(TokenTagDictionary)
+	detector := gocv.NewArucoDetectorWithParams(dict, params)
+	defer detector.Close()
+
+	t.SetExposure(tagExposure)
+
+	for {
+		select {
+		case <-ctx.Done():
+			fmt.Println("Done detecting tags")
+			deregister()
+			return
+		case <-frameListener:
+			if t.camera.GetExposure() != tagExposure {
+				t.SetExposure(tagExposure)
+			}
+			frameTime := time.Now()
+			t.copyFrame(&frame)
+			if frame.Empty() {
+				continue
+			}
+			gocv.CvtColor(frame, &gray, gocv.ColorBGRToGray)
+
+			corners, tagIds, _ := detector.DetectMarkers(gray)
+
+			frame.CopyTo(&output)
+			if len(tagIds) > 0 {
+				gocv.ArucoDrawDetectedMarkers(output, corners, tagIds, gocv.NewScalar(0, 255, 0, 0))
+			}
+			t.publishDebugFrame("tags", output)
+
+			t.markers.mutex.Lock()
+			t.expireMarkers(frameTime)
+
+			seen := make(map[int]bool, len(tagIds))
+			for i, tagID := range tagIds {
+				// The same tag on two tokens can't be told apart, so only the
+				// first is tracked
+				if seen[tagID] {
+					continue
+				}
+
+				tag := t.clipDetections([]detection{measureTag(corners[i])})
+				if len(tag) == 0 {
+					continue
+				}
+				seen[tagID] = true
+
+				t.observeTag(tagID, tag[0], frameTime)
+			}
+
+			t.updateMarkerLifecycles(frameTime)
+			t.markers.mutex.Unlock()
+		}
+	}
+}
+
+// observeTag updates the marker for the tag, creating it if the tag hasn't been
+// seen before
+func (t *Tracker) observeTag(tagID int, d detection, frameTime time.Time) {
+	for _, marker := range t.markers.all() {
+		if marker.TokenID != tagID {
+			continue
+		}
+
+		recovered := marker.Lost
+		marker.observe(d, frameTime)
+		if recovered {
+			t.emitMarkerEvent(MarkerRecovered, marker, frameTime)
+		}
+		return
+	}
+
+	fmt.Println("Found new tag", tagID, d.position)
+	marker := newMarker(d, frameTime)
+	marker.TokenID = tagID
+	_, err := t.markers.add(marker)
+	if err != nil {
+		fmt.Println("Ignoring new tag:", err)
+	}
+}
+
+// measureTag finds the center of a tag from its corners, which are in
+// clockwise order from the top left, and the direction from its center to its
+// top edge
+func measureTag(corners []gocv.Point2f) detection {
+	center := gocv.Point2f{}
+	for _, corner := range corners {
+		center.X += corner.X / float32(len(corners))
+		center.Y += corner.Y / float32(len(corners))
+	}
+
+	top := gocv.Point2f{
+		X: (corners[0].X + corners[1].X) / 2,
+		Y: (corners[0].Y + corners[1].Y) / 2,
+	}
+
+	// Shoelace formula
+	area := 0.0
+	for i := range corners {
+		a, b := corners[i], corners[(i+1)%len(corners)]
+		area += float64(a.X*b.Y - b.X*a.Y)
+	}
+
+	area = math.Abs(area) / 2
+
+	return detection{
+		position:          center,
+		color:             MarkerColorUnknown,
+		area:              area,
+		heading:           math.Atan2(float64(top.Y-center.Y), float64(top.X-center.X)),
+		headingConfidence: math.Min(1, math.Sqrt(area)/fullConfidenceTagSide),
+		headingSource:     HeadingSourceTag,
+	}
+}
@@ -0,0 +1,180 @@
+package tracker
+
+import (
+	"image"
+
+	"gocv.io/x/gocv"
+)
+
+const (
+	// DisplayMatchRadius is how close, in table units, displayed content of a
+	// marker's color has to be for a new blob to be put down to the display
+	// rather than an LED
+	DisplayMatchRadius = 0.5
+)
+
+// DisplayMask describes what the table is displaying, so that bright parts of
+// the image, e.g. lava or fire, aren't mistaken for markers
+type DisplayMask struct {
+	// Image is what the table displays, covering the table from Origin to
+	// Origin + Size in table units. It may be empty.
+	Image  gocv.Mat
+	Origin gocv.Point2f
+	Size   gocv.Point2f
+
+	// Exclusions are polygons on the table, in table units, where markers are
+	// never tracked
+	Exclusions [][]gocv.Point2f
+}
+
+// displayMask is a DisplayMask prepared for looking up detections
+type displayMask struct {
+	origin     gocv.Point2f
+	size       gocv.Point2f
+	exclusions [][]gocv.Point2f
+
+	// Where the displayed image has content that could pass for an LED of
+	// each color, grown by DisplayMatchRadius. The unknown color's mask is
+	// content of any color.
+	displayed [numMarkerColors]gocv.Mat
+}
+
+// SetDisplayMask replaces what the tracker masks out of marker detection. The
+// image is copied, so may be closed afterwards.
+func (t *Tracker) SetDisplayMask(mask DisplayMask) {
+	prepared := &displayMask{
+		origin:     mask.Origin,
+		size:       mask.Size,
+		exclusions: mask.Exclusions,
+	}
+	for i := range prepared.displayed {
+		prepared.displayed[i] = gocv.NewMat()
+	}
+
+	if !mask.Image.Empty() && mask.Size.X > 0 && mask.Size.Y > 0 {
+		masks := newColorMasks()
+		masks.threshold(mask.Image)
+
+		for i, r := range markerColorRanges {
+			if prepared.displayed[r.color].Empty() {
+				masks.ranges[i].CopyTo(&prepared.displayed[r.color])
+			} else {
+				gocv.BitwiseOr(prepared.displayed[r.color], masks.ranges[i], &prepared.displayed[r.color])
+			}
+		}
+		masks.combined.CopyTo(&prepared.displayed[MarkerColorUnknown])
+		masks.close()
+
+		radius := int(DisplayMatchRadius*float64(mask.Image.Cols())/float64(mask.Size.X) + 0.5)
+		if radius > 0 {
+			kernel := gocv.GetStructuringElement(gocv.MorphEllipse, image.Pt(2*radius+1, 2*radius+1))
+			for i := range prepared.displayed {
+				gocv.Dilate(prepared.displayed[i], &prepared.displayed[i], kernel)
+			}
+			kernel.Close()
+		}
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.displayMask != nil {
+		t.displayMask.close()
+	}
+	t.displayMask = prepared
+}
+
+func (m *displayMask) close() {
+	for i := range m.displayed {
+		m.displayed[i].Close()
+	}
+}
+
+// excludeDetections drops detections that fall within an exclusion polygon.
+// Nothing is excluded until the pose has been calibrated.
+func (t *Tracker) excludeDetections(detections []detection) []detection {
+	if !t.isPoseCalibrated() {
+		return detections
+	}
+
+	points := make([]gocv.Point2f, len(detections))
+	for i, d := range detections {
+		points[i] = t.pixelToSurface(d.position)
+	}
+
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	if t.displayMask == nil || len(t.displayMask.exclusions) == 0 {
+		return detections
+	}
+
+	kept := []detection{}
+	for i, d := range detections {
+		excluded := false
+		for _, polygon := range t.displayMask.exclusions {
+			if pointInPolygon(points[i], polygon) {
+				excluded = true
+				break
+			}
+		}
+		if !excluded {
+			kept = append(kept, d)
+		}
+	}
+	return kept
+}
+
+// isDisplayed returns whether the table is displaying content of the
+// detection's color where it was seen, in which case a new marker there is
+// more likely the display than an LED. Markers that are already tracked are
+// followed across such content.
+func (t *Tracker) isDisplayed(d detection) bool {
+	if !t.isPoseCalibrated() {
+		return false
+	}
+	point := t.pixelToSurface(d.position)
+
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	if t.displayMask == nil {
+		return false
+	}
+	displayed := t.displayMask.displayed[d.color]
+	if displayed.Empty() {
+		return false
+	}
+
+	x := int(float64((point.X-t.displayMask.origin.X)/t.displayMask.size.X) * float64(displayed.Cols()))
+	y := int(float64((point.Y-t.displayMask.origin.Y)/t.displayMask.size.Y) * float64(displayed.Rows()))
+	if x < 0 || y < 0 || x >= displayed.Cols() || y >= displayed.Rows() {
+		return false
+	}
+	return displayed.GetUCharAt(y, x) != 0
+}
+
+// pixelToSurface maps a pixel onto the surface of the table, where the display
+// is, rather than the height of the markers' LEDs
+func (t *Tracker) pixelToSurface(pixel gocv.Point2f) gocv.Point2f {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	undistorted := t.intrinsics.UndistortPoint(pixel)
+	point := t.poseCalibration.PixelTo3D(undistorted, 0)
+	return gocv.Point2f{X: point.X, Y: point.Y}
+}
+
+// pointInPolygon returns whether the point is inside the polygon, by counting
+// how many of its edges a ray from the point crosses
+func pointInPolygon(point gocv.Point2f, polygon []gocv.Point2f) bool {
+	inside := false
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		a, b := polygon[i], polygon[j]
+		if (a.Y > point.Y) != (b.Y > point.Y) &&
+			point.X < (b.X-a.X)*(point.Y-a.Y)/(b.Y-a.Y)+a.X {
+			inside = !inside
+		}
+	}
+	return inside
+}
@@ -0,0 +1,213 @@
+package tracker
+
+import (
+	"math"
+
+	"gocv.io/x/gocv"
+)
+
+// HeadingSource is how a marker's heading was worked out
+type HeadingSource int
+
+const (
+	// Sources are in order of preference
+	HeadingSourceNone HeadingSource = iota
+
+	// HeadingSourceTag is the direction of the top edge of the token's ArUco
+	// tag
+	HeadingSourceTag
+
+	// HeadingSourceLEDPair is the direction from the back LED of a two LED
+	// base to its front LED
+	HeadingSourceLEDPair
+
+	// HeadingSourceElongation is the long axis of an elongated blob, e.g. a
+	// light bar. Which end is the front can't be told, so it may be off by half
+	// a turn.
+	HeadingSourceElongation
+)
+
+func (s HeadingSource) String() string {
+	switch s {
+	case HeadingSourceTag:
+		return "tag"
+	case HeadingSourceLEDPair:
+		return "LED pair"
+	case HeadingSourceElongation:
+		return "elongation"
+	default:
+		return "none"
+	}
+}
+
+const (
+	// How much of each new heading is blended into a marker's heading, to
+	// smooth out jitter
+	headingSmoothing = 0.5
+
+	// How much a marker's heading confidence drops each time it is seen
+	// without a heading, and the confidence below which the heading is dropped
+	headingConfidenceDecay = 0.9
+	minHeadingConfidence   = 0.05
+
+	// Tags with sides this long, and LED pairs this far apart, in pixels, have
+	// full confidence. Smaller ones have noisier headings.
+	fullConfidenceTagSide     = 20
+	fullConfidenceLEDPairSide = 20
+
+	// Blobs have to be at least this elongated, from 0 for a circle towards 1
+	// for a line, to have a heading, and have full confidence at
+	// fullConfidenceElongation
+	minHeadingElongation     = 0.3
+	fullConfidenceElongation = 0.6
+
+	// PairFrontColor and PairBackColor are the colors of the LEDs at the front
+	// and back of a two LED base. Single LED tokens use the same colors, so
+	// pairing is only done when enabled with SetLEDPairHeadings.
+	PairFrontColor = MarkerColorGreen
+	PairBackColor  = MarkerColorRed
+
+	// MaxLEDPairDistance is how far apart, in table units, the LEDs of a two
+	// LED base can be
+	MaxLEDPairDistance = 1.5
+
+	// The same distance in pixels, used before the pose has been calibrated
+	maxLEDPairPixels = 40
+)
+
+// observeHeading updates the marker's heading from a detection. Headings from
+// a less preferred source than the marker's current one are ignored, so that it
+// is kept until its confidence has decayed.
+func (m *Marker) observeHeading(d detection) {
+	if d.headingSource == HeadingSourceNone || (m.HasHeading && m.HeadingSource < d.headingSource) {
+		m.decayHeading()
+		return
+	}
+
+	m.updateHeading(d.heading, d.headingConfidence, d.headingSource)
+}
+
+// updateHeading blends a new heading into the marker's. A heading from a
+// different source replaces the marker's outright.
+func (m *Marker) updateHeading(heading, confidence float64, source HeadingSource) {
+	if !m.HasHeading || m.HeadingSource != source {
+		m.Heading = heading
+	} else {
+		if source == HeadingSourceElongation {
+			// Keep the end of the axis closest to the current heading
+			if math.Abs(math.Remainder(heading-m.Heading, 2*math.Pi)) > math.Pi/2 {
+				heading += math.Pi
+			}
+		}
+		m.Heading = blendAngles(m.Heading, heading, headingSmoothing)
+	}
+
+	m.HasHeading = true
+	m.HeadingConfidence = confidence
+	m.HeadingSource = source
+}
+
+// decayHeading lowers the confidence in the marker's heading when it is seen
+// without one, dropping the heading once it is too uncertain
+func (m *Marker) decayHeading() {
+	if !m.HasHeading {
+		return
+	}
+
+	m.HeadingConfidence *= headingConfidenceDecay
+	if m.HeadingConfidence < minHeadingConfidence {
+		m.HasHeading = false
+		m.HeadingConfidence = 0
+		m.HeadingSource = HeadingSourceNone
+	}
+}
+
+// blobElongation finds the long axis of a blob from its central moments, and
+// how elongated the blob is, from 0 for a circle towards 1 for a line
+func blobElongation(moments map[string]float64) (axis float64, elongation float64) {
+	mu20, mu02, mu11 := moments["mu20"], moments["mu02"], moments["mu11"]
+
+	spread := math.Sqrt((mu20-mu02)*(mu20-mu02) + 4*mu11*mu11)
+	major := (mu20 + mu02 + spread) / 2
+	minor := (mu20 + mu02 - spread) / 2
+	if major <= 0 || minor < 0 {
+		return 0, 0
+	}
+
+	return 0.5 * math.Atan2(2*mu11, mu20-mu02), 1 - math.Sqrt(minor/major)
+}
+
+// pairLEDs gives the markers of two LED bases the heading from their back LED
+// to their front LED. Each front LED is paired with the closest back LED within
+// reach, if it is also the back LED's closest front LED.
+func (t *Tracker) pairLEDs() {
+	t.mutex.RLock()
+	enabled := t.ledPairHeadings
+	t.mutex.RUnlock()
+
+	fronts := []*Marker{}
+	backs := []*Marker{}
+	for _, marker := range t.markers.all() {
+		marker.PairedKey = 0
+		if marker.Lost || !enabled {
+			continue
+		}
+
+		switch marker.Color {
+		case PairFrontColor:
+			fronts = append(fronts, marker)
+		case PairBackColor:
+			backs = append(backs, marker)
+		}
+	}
+	if len(fronts) == 0 || len(backs) == 0 {
+		return
+	}
+
+	positions := make([]gocv.Point2f, 0, len(fronts)+len(backs))
+	for _, marker := range fronts {
+		positions = append(positions, marker.Position)
+	}
+	for _, marker := range backs {
+		positions = append(positions, marker.Position)
+	}
+	points := t.associationSpace(positions)
+	frontPoints, backPoints := points[:len(fronts)], points[len(fronts):]
+
+	maxDistance := MaxLEDPairDistance
+	if !t.isPoseCalibrated() {
+		maxDistance = maxLEDPairPixels
+	}
+
+	closest := func(point gocv.Point2f, candidates []gocv.Point2f) int {
+		best := -1
+		for i, candidate := range candidates {
+			d := distance(point, candidate)
+			if d <= maxDistance && (best < 0 || d < distance(point, candidates[best])) {
+				best = i
+			}
+		}
+		return best
+	}
+
+	for i, front := range fronts {
+		j := closest(frontPoints[i], backPoints)
+		if j < 0 || closest(backPoints[j], frontPoints) != i {
+			continue
+		}
+		back := backs[j]
+
+		heading := math.Atan2(float64(front.Position.Y-back.Position.Y), float64(front.Position.X-back.Position.X))
+		confidence := math.Min(1, distance(front.Position, back.Position)/fullConfidenceLEDPairSide)
+		front.updateHeading(heading, confidence, HeadingSourceLEDPair)
+		back.updateHeading(heading, confidence, HeadingSourceLEDPair)
+		front.PairedKey = back.Key()
+		back.PairedKey = front.Key()
+	}
+}
+
+// blendAngles moves angle a towards angle b by the given fraction, the short
+// way round
+func blendAngles(a, b, fraction float64) float64 {
+	return math.Remainder(a+fraction*math.Remainder(b-a, 2*math.Pi), 2*math.Pi)
+}
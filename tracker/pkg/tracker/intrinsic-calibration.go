@@ -0,0 +1,156 @@
+package tracker
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"math"
+	"time"
+
+	"github.com/tutman96/fantassist.io/tracker/pkg/calib3d"
+	"gocv.io/x/gocv"
+)
+
+const (
+	MinIntrinsicCalibrationViews = 10
+	// Calibration finishes on its own once this many views are captured, as
+	// more add little accuracy but make CalibrateCamera much slower
+	MaxIntrinsicCalibrationViews = 40
+
+	// The checkerboard has to move at least this many pixels on average between
+	// captured views, so the calibration sees it across the whole frame rather
+	// than the same view many times over
+	minIntrinsicViewDisplacement = 20
+)
+
+// CalibrateIntrinsics captures views of a checkerboard with patternSize inner
+// corners and squares squareSize apart until the context is cancelled, then
+// computes the camera matrix and lens distortion from them. It stops early
+// once MaxIntrinsicCalibrationViews views are captured
+func (t *Tracker) CalibrateIntrinsics(ctx context.Context, patternSize image.Point, squareSize float32, loopRate time.Duration) (*calib3d.CameraIntrinsics, error) {
+	frame := gocv.NewMat()
+	defer frame.Close()
+	gray := gocv.NewMat()
+	defer gray.Close()
+	output := gocv.NewMat()
+	defer output.Close()
+
+	patternPoints := make([]gocv.Point3f, 0, patternSize.X*patternSize.Y)
+	for y := 0; y < patternSize.Y; y++ {
+		for x := 0; x < patternSize.X; x++ {
+			patternPoints = append(patternPoints, gocv.Point3f{
+				X: float32(x) * squareSize,
+				Y: float32(y) * squareSize,
+			})
+		}
+	}
+
+	views := [][]gocv.Point2f{}
+	t.mutex.Lock()
+	t.intrinsicViewsCaptured = 0
+	t.mutex.Unlock()
+
+	ticker := time.NewTicker(loopRate)
+
+	for {
+		select {
+		case <-ctx.Done():
+			ticker.Stop()
+
+			if len(views) < MinIntrinsicCalibrationViews {
+				return nil, fmt.Errorf("only captured %d of %d views of the checkerboard", len(views), MinIntrinsicCalibrationViews)
+			}
+
+			return t.computeIntrinsics(patternPoints, views)
+		case <-ticker.C:
+			t.SetExposure(15000)
+			t.copyFrame(&frame)
+			if frame.Empty() {
+				continue
+			}
+			gocv.CvtColor(frame, &gray, gocv.ColorBGRToGray)
+			frame.CopyTo(&output)
+
+			corners := gocv.NewMat()
+			found := gocv.FindChessboardCorners(gray, patternSize, &corners, gocv.CalibCBAdaptiveThresh|gocv.CalibCBNormalizeImage|gocv.CalibCBFastCheck)
+			if found {
+				gocv.CornerSubPix(gray, &corners, image.Pt(11, 11), image.Pt(-1, -1), gocv.NewTermCriteria(gocv.Count|gocv.EPS, 30, 0.001))
+				gocv.DrawChessboardCorners(&output, patternSize, corners, found)
+
+				pointVector := gocv.NewPoint2fVectorFromMat(corners)
+				points := pointVector.ToPoints()
+				pointVector.Close()
+
+				if len(views) == 0 || meanDisplacement(views[len(views)-1], points) > minIntrinsicViewDisplacement {
+					views = append(views, points)
+					t.mutex.Lock()
+					t.intrinsicViewsCaptured = len(views)
+					t.mutex.Unlock()
+					fmt.Println("Captured checkerboard view", len(views))
+				}
+			}
+			corners.Close()
+			t.publishDebugFrame("intrinsics", output)
+
+			if len(views) >= MaxIntrinsicCalibrationViews {
+				ticker.Stop()
+				return t.computeIntrinsics(patternPoints, views)
+			}
+		}
+	}
+}
+
+func (t *Tracker) computeIntrinsics(patternPoints []gocv.Point3f, views [][]gocv.Point2f) (*calib3d.CameraIntrinsics, error) {
+	objectPoints := gocv.NewPoints3fVector()
+	defer objectPoints.Close()
+	imagePoints := gocv.NewPoints2fVector()
+	defer imagePoints.Close()
+
+	for _, view := range views {
+		objectVector := gocv.NewPoint3fVectorFromPoints(patternPoints)
+		objectPoints.Append(objectVector)
+		objectVector.Close()
+
+		imageVector := gocv.NewPoint2fVectorFromPoints(view)
+		imagePoints.Append(imageVector)
+		imageVector.Close()
+	}
+
+	cameraMatrix := gocv.NewMat()
+	distCoeffs := gocv.NewMat()
+	rvecs := gocv.NewMat()
+	defer rvecs.Close()
+	tvecs := gocv.NewMat()
+	defer tvecs.Close()
+
+	reprojectionError := gocv.CalibrateCamera(objectPoints, imagePoints, t.resolution, &cameraMatrix, &distCoeffs, &rvecs, &tvecs, 0)
+	if cameraMatrix.Empty() {
+		cameraMatrix.Close()
+		distCoeffs.Close()
+		return nil, fmt.Errorf("camera calibration failed")
+	}
+
+	fmt.Println("Calibrated camera intrinsics with reprojection error", reprojectionError)
+
+	return &calib3d.CameraIntrinsics{
+		Resolution:        t.resolution,
+		CalibratedAt:      time.Now(),
+		ReprojectionError: reprojectionError,
+		CameraMatrix:      cameraMatrix,
+		DistCoeffs:        distCoeffs,
+	}, nil
+}
+
+func meanDisplacement(a, b []gocv.Point2f) float64 {
+	if len(a) != len(b) || len(a) == 0 {
+		return math.Inf(1)
+	}
+
+	total := 0.0
+	for i := range a {
+		dx := float64(a[i].X - b[i].X)
+		dy := float64(a[i].Y - b[i].Y)
+		total += math.Sqrt(dx*dx + dy*dy)
+	}
+	return total / float64(len(a))
+}
@@ -0,0 +1,109 @@
+package tracker
+
+import (
+	"time"
+
+	"gocv.io/x/gocv"
+)
+
+const (
+	// How much a token is expected to accelerate, in pixels per second squared.
+	// Larger values follow sudden movements more closely but smooth less.
+	kalmanAccelerationNoise = 2000
+
+	// How far, in pixels, a detection is expected to be from the LED's true
+	// position
+	kalmanMeasurementNoise = 2
+
+	// Uncertainty, in pixels per second, of the velocity of a new marker
+	kalmanInitialVelocityNoise = 1000
+)
+
+// axisFilter is a constant velocity Kalman filter along a single axis. The axes
+// of the image are independent, so filtering them separately is equivalent to
+// a single filter over both and avoids any matrix maths.
+type axisFilter struct {
+	position float64
+	velocity float64
+
+	// Covariance of the position and velocity
+	p00, p01, p10, p11 float64
+}
+
+func newAxisFilter(position float64) axisFilter {
+	return axisFilter{
+		position: position,
+		p00:      kalmanMeasurementNoise * kalmanMeasurementNoise,
+		p11:      kalmanInitialVelocityNoise * kalmanInitialVelocityNoise,
+	}
+}
+
+func (f *axisFilter) predict(dt float64) {
+	f.position += f.velocity * dt
+
+	// P = F P F^T + Q for F = [1 dt; 0 1]
+	p00 := f.p00 + dt*(f.p10+f.p01) + dt*dt*f.p11
+	p01 := f.p01 + dt*f.p11
+	p10 := f.p10 + dt*f.p11
+
+	q := float64(kalmanAccelerationNoise * kalmanAccelerationNoise)
+	f.p00 = p00 + q*dt*dt*dt*dt/4
+	f.p01 = p01 + q*dt*dt*dt/2
+	f.p10 = p10 + q*dt*dt*dt/2
+	f.p11 += q * dt * dt
+}
+
+func (f *axisFilter) correct(measurement float64) {
+	innovation := measurement - f.position
+	s := f.p00 + kalmanMeasurementNoise*kalmanMeasurementNoise
+	k0 := f.p00 / s
+	k1 := f.p10 / s
+
+	f.position += k0 * innovation
+	f.velocity += k1 * innovation
+
+	p00, p01 := f.p00, f.p01
+	f.p00 = (1 - k0) * p00
+	f.p01 = (1 - k0) * p01
+	f.p10 -= k1 * p00
+	f.p11 -= k1 * p01
+}
+
+// markerFilter smooths a marker's detections and estimates its velocity
+type markerFilter struct {
+	x, y    axisFilter
+	updated time.Time
+}
+
+func newMarkerFilter(position gocv.Point2f, at time.Time) *markerFilter {
+	return &markerFilter{
+		x:       newAxisFilter(float64(position.X)),
+		y:       newAxisFilter(float64(position.Y)),
+		updated: at,
+	}
+}
+
+// predict advances the filter to the given time
+func (f *markerFilter) predict(at time.Time) {
+	dt := at.Sub(f.updated).Seconds()
+	if dt <= 0 {
+		return
+	}
+
+	f.x.predict(dt)
+	f.y.predict(dt)
+	f.updated = at
+}
+
+func (f *markerFilter) correct(position gocv.Point2f) {
+	f.x.correct(float64(position.X))
+	f.y.correct(float64(position.Y))
+}
+
+func (f *markerFilter) position() gocv.Point2f {
+	return gocv.Point2f{X: float32(f.x.position), Y: float32(f.y.position)}
+}
+
+func (f *markerFilter) velocity() gocv.Point2f {
+	return gocv.Point2f{X: float32(f.x.velocity), Y: float32(f.y.velocity)}
+}
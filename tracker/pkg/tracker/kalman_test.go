@@ -0,0 +1,83 @@
+package tracker
+
+import (
+	"math"
+	"testing"
+)
+
+const testFrameInterval = 1.0 / 30
+
+// trackAxis filters a token moving at a constant velocity, sampled once per
+// frame with measurement noise that alternates between -noise and +noise. It
+// returns the filter after the last frame, and the token's true position.
+func trackAxis(start, velocity, noise float64, frames int) (axisFilter, float64) {
+	f := newAxisFilter(start)
+	position := start
+	for frame := 1; frame < frames; frame++ {
+		position += velocity * testFrameInterval
+
+		measurement := position + noise
+		if frame%2 == 0 {
+			measurement = position - noise
+		}
+
+		f.predict(testFrameInterval)
+		f.correct(measurement)
+	}
+	return f, position
+}
+
+func TestAxisFilterConverges(t *testing.T) {
+	f, position := trackAxis(100, 0, 1, 60)
+
+	if math.Abs(f.position-position) > 1 {
+		t.Errorf("position is %v, expected %v", f.position, position)
+	}
+	if math.Abs(f.velocity) > 30 {
+		t.Errorf("velocity is %v, expected 0", f.velocity)
+	}
+
+	// The position should be known better than a single measurement
+	if f.p00 >= kalmanMeasurementNoise*kalmanMeasurementNoise {
+		t.Errorf("position variance is %v, expected less than %v", f.p00, kalmanMeasurementNoise*kalmanMeasurementNoise)
+	}
+	if f.p11 >= kalmanInitialVelocityNoise*kalmanInitialVelocityNoise {
+		t.Errorf("velocity variance is %v, expected less than %v", f.p11, kalmanInitialVelocityNoise*kalmanInitialVelocityNoise)
+	}
+}
+
+func TestAxisFilterVelocity(t *testing.T) {
+	tests := []struct {
+		name     string
+		velocity float64
+		noise    float64
+	}{
+		{"slow", 30, 0},
+		{"fast", 600, 0},
+		{"backwards", -250, 0},
+		{"noisy", 300, 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, position := trackAxis(640, test.velocity, test.noise, 60)
+
+			if math.Abs(f.position-position) > 1+test.noise {
+				t.Errorf("position is %v, expected %v", f.position, position)
+			}
+
+			tolerance := 0.05*math.Abs(test.velocity) + 30*test.noise
+			if math.Abs(f.velocity-test.velocity) > tolerance {
+				t.Errorf("velocity is %v, expected %v±%v", f.velocity, test.velocity, tolerance)
+			}
+
+			// The estimated velocity should carry the prediction forward to
+			// where the token is next frame
+			f.predict(testFrameInterval)
+			next := position + test.velocity*testFrameInterval
+			if math.Abs(f.position-next) > 1+2*test.noise {
+				t.Errorf("predicted position is %v, expected %v", f.position, next)
+			}
+		})
+	}
+}
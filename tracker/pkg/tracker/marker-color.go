@@ -0,0 +1,144 @@
+package tracker
+
+import (
+	"image"
+
+	"gocv.io/x/gocv"
+)
+
+// MarkerColor is the color of a marker's LED, used to tell kinds of tokens
+// apart, e.g. players from monsters
+type MarkerColor int
+
+const (
+	MarkerColorUnknown MarkerColor = iota
+	MarkerColorRed
+	MarkerColorGreen
+	MarkerColorBlue
+
+	numMarkerColors
+)
+
+func (c MarkerColor) String() string {
+	switch c {
+	case MarkerColorRed:
+		return "red"
+	case MarkerColorGreen:
+		return "green"
+	case MarkerColorBlue:
+		return "blue"
+	default:
+		return "unknown"
+	}
+}
+
+// hsvRange is a box in OpenCV's HSV space, where hue runs from 0 to 180
+type hsvRange struct {
+	color MarkerColor
+	lower gocv.Scalar
+	upper gocv.Scalar
+}
+
+// Pixels saturated enough to be part of an LED of each color. How bright they
+// have to be is decided separately. Red wraps around the end of the hue range
+// so needs two.
+var markerColorRanges = []hsvRange{
+	{MarkerColorRed, gocv.NewScalar(0, 80, 0, 0), gocv.NewScalar(10, 255, 255, 0)},
+	{MarkerColorRed, gocv.NewScalar(170, 80, 0, 0), gocv.NewScalar(180, 255, 255, 0)},
+	{MarkerColorGreen, gocv.NewScalar(45, 80, 0, 0), gocv.NewScalar(85, 255, 255, 0)},
+	{MarkerColorBlue, gocv.NewScalar(100, 80, 0, 0), gocv.NewScalar(130, 255, 255, 0)},
+}
+
+// An LED bright enough to overexpose the camera has a white core with only a
+// rim of its color, so pixels this bright that are too washed out for any of
+// the color ranges are part of an LED too
+var whiteCoreRange = hsvRange{MarkerColorUnknown, gocv.NewScalar(0, 0, 230, 0), gocv.NewScalar(180, 79, 255, 0)}
+
+// colorMasks thresholds frames into a mask per LED color, a mask of white LED
+// cores, and the union of them
+type colorMasks struct {
+	hsv      gocv.Mat
+	value    gocv.Mat
+	bright   gocv.Mat
+	ranges   []gocv.Mat
+	white    gocv.Mat
+	combined gocv.Mat
+}
+
+func newColorMasks() *colorMasks {
+	m := &colorMasks{
+		hsv:      gocv.NewMat(),
+		value:    gocv.NewMat(),
+		bright:   gocv.NewMat(),
+		ranges:   make([]gocv.Mat, len(markerColorRanges)),
+		white:    gocv.NewMat(),
+		combined: gocv.NewMat(),
+	}
+	for i := range m.ranges {
+		m.ranges[i] = gocv.NewMat()
+	}
+	return m
+}
+
+func (m *colorMasks) close() {
+	m.hsv.Close()
+	m.value.Close()
+	m.bright.Close()
+	for i := range m.ranges {
+		m.ranges[i].Close()
+	}
+	m.white.Close()
+	m.combined.Close()
+}
+
+// threshold updates the masks from a BGR frame, counting pixels at least
+// thresholdMinimum bright as bright enough to be an LED
+func (m *colorMasks) threshold(frame gocv.Mat) {
+	m.convert(frame)
+	gocv.Threshold(m.value, &m.bright, thresholdMinimum-1, 255, gocv.ThresholdBinary)
+	m.split()
+}
+
+// convert updates the HSV and value channels from a BGR frame, ready for the
+// bright mask to be worked out
+func (m *colorMasks) convert(frame gocv.Mat) {
+	gocv.CvtColor(frame, &m.hsv, gocv.ColorBGRToHSV)
+	gocv.ExtractChannel(m.hsv, &m.value, 2)
+}
+
+// split updates the color masks from the bright pixels
+func (m *colorMasks) split() {
+	for i, r := range markerColorRanges {
+		gocv.InRangeWithScalar(m.hsv, r.lower, r.upper, &m.ranges[i])
+		gocv.BitwiseAnd(m.ranges[i], m.bright, &m.ranges[i])
+		if i == 0 {
+			m.ranges[i].CopyTo(&m.combined)
+		} else {
+			gocv.BitwiseOr(m.combined, m.ranges[i], &m.combined)
+		}
+	}
+
+	gocv.InRangeWithScalar(m.hsv, whiteCoreRange.lower, whiteCoreRange.upper, &m.white)
+	gocv.BitwiseAnd(m.white, m.bright, &m.white)
+	gocv.BitwiseOr(m.combined, m.white, &m.combined)
+}
+
+// classify returns the color with the most pixels within the rectangle. White
+// cores don't count, so an overexposed LED is classified by its rim, and a blob
+// that is white all over is MarkerColorUnknown.
+func (m *colorMasks) classify(rect image.Rectangle) MarkerColor {
+	counts := [numMarkerColors]int{}
+	for i, r := range markerColorRanges {
+		region := m.ranges[i].Region(rect)
+		counts[r.color] += gocv.CountNonZero(region)
+		region.Close()
+	}
+
+	best := MarkerColorUnknown
+	for color, count := range counts {
+		if count > counts[best] {
+			best = MarkerColor(color)
+		}
+	}
+	return best
+}
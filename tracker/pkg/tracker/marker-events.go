@@ -0,0 +1,100 @@
+package tracker
+
+import (
+	"fmt"
+	"time"
+)
+
+type MarkerEventType int
+
+const (
+	// MarkerAppeared is sent once a new marker has been seen for MinMarkerAge
+	MarkerAppeared MarkerEventType = iota
+
+	// MarkerMoved is sent when a marker has moved more than
+	// MarkerMoveThreshold since it appeared or last moved
+	MarkerMoved
+
+	// MarkerLost is sent when a marker hasn't been seen for a moment. It keeps
+	// its identity and last position for the lost grace period.
+	MarkerLost
+
+	// MarkerRecovered is sent when a lost marker is seen again
+	MarkerRecovered
+
+	// MarkerRemoved is sent when a lost marker's grace period is over
+	MarkerRemoved
+)
+
+func (e MarkerEventType) String() string {
+	switch e {
+	case MarkerAppeared:
+		return "appeared"
+	case MarkerMoved:
+		return "moved"
+	case MarkerLost:
+		return "lost"
+	case MarkerRecovered:
+		return "recovered"
+	case MarkerRemoved:
+		return "removed"
+	default:
+		return "unknown"
+	}
+}
+
+// MarkerEvent describes a change to a marker. Marker is a copy of the marker at
+// the time of the event, and Key is the key clients know it by.
+type MarkerEvent struct {
+	Type   MarkerEventType
+	Key    int32
+	Marker Marker
+	At     time.Time
+}
+
+// RegisterMarkerEventListener returns a channel that receives every marker
+// event, and a function to stop receiving them. Events are dropped if the
+// channel's buffer is full.
+func (t *Tracker) RegisterMarkerEventListener() (<-chan MarkerEvent, func()) {
+	listener := make(chan MarkerEvent, 64)
+
+	t.mutex.Lock()
+	t.markerEventListeners = append(t.markerEventListeners, listener)
+	t.mutex.Unlock()
+
+	return listener, func() {
+		t.mutex.Lock()
+		for i, l := range t.markerEventListeners {
+			if l == listener {
+				t.markerEventListeners = append(t.markerEventListeners[:i], t.markerEventListeners[i+1:]...)
+				break
+			}
+		}
+		t.mutex.Unlock()
+
+		close(listener)
+	}
+}
+
+func (t *Tracker) emitMarkerEvent(eventType MarkerEventType, marker *Marker, at time.Time) {
+	if eventType != MarkerMoved {
+		fmt.Println("Marker", marker.Key(), eventType)
+	}
+
+	event := MarkerEvent{
+		Type:   eventType,
+		Key:    marker.Key(),
+		Marker: *marker,
+		At:     at,
+	}
+
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	for _, listener := range t.markerEventListeners {
+		select {
+		case listener <- event:
+		default:
+			// noop
+		}
+	}
+}
@@ -1,19 +1,151 @@
 package tracker
 
 import (
-	"image"
+	"fmt"
+	"sync"
 	"time"
+
+	"gocv.io/x/gocv"
 )
 
 // Marker set represents a sparse list of markers, each with their own identifier and position
 type Marker struct {
 	Identifier byte
-	Position   image.Point
-	FirstSeen  time.Time
-	LastSeen   time.Time
+
+	// Position is the filtered pixel location of the marker, predicted forward
+	// to the latest frame, and Velocity its movement in pixels per second
+	Position  gocv.Point2f
+	Velocity  gocv.Point2f
+	FirstSeen time.Time
+	LastSeen  time.Time
+
+	// Shape of the marker's blob when it was last seen. Area is in pixels,
+	// Circularity is 1 for a perfect circle and Brightness is the mean value of
+	// the blob's pixels from 0 to 255.
+	Area        float64
+	Circularity float64
+	Brightness  float64
+
+	// Appeared is set once the marker has been seen for MinMarkerAge, and
+	// markers are only reported to clients after that
+	Appeared bool
+
+	// Lost markers haven't been seen for a moment. They stay where they were
+	// last seen, keeping their identity, until the lost grace period is over
+	// or they are seen again.
+	Lost   bool
+	LostAt time.Time
+
+	// TokenID is the ID decoded from the marker's blinking LED or read from its
+	// ArUco tag, or -1 if it hasn't been decoded
+	TokenID int
+
+	// Heading is which way the marker faces in the image, in radians from the
+	// x axis towards the y axis, if HasHeading is set. HeadingConfidence is
+	// from 0 to 1, and drops while the heading can't be measured.
+	Heading           float64
+	HasHeading        bool
+	HeadingConfidence float64
+	HeadingSource     HeadingSource
+
+	// PairedKey is the key of the other LED of a two LED base, or 0
+	PairedKey int32
+
+	// Color is the color the marker's LED has most often been seen as
+	Color MarkerColor
+
+	filter      *markerFilter
+	decoder     blinkDecoder
+	colorCounts [numMarkerColors]int
+
+	// The filtered position when the marker was last seen, and when it last
+	// appeared or moved
+	observed gocv.Point2f
+	reported gocv.Point2f
+}
+
+// newMarker creates a marker from its first detection
+func newMarker(d detection, at time.Time) Marker {
+	marker := Marker{
+		Position:    d.position,
+		FirstSeen:   at,
+		LastSeen:    at,
+		Area:        d.area,
+		Circularity: d.circularity,
+		Brightness:  d.brightness,
+		TokenID:     -1,
+		Color:       d.color,
+		filter:      newMarkerFilter(d.position, at),
+		observed:    d.position,
+	}
+	marker.colorCounts[d.color]++
+	marker.observeHeading(d)
+	return marker
+}
+
+// Key identifies the marker to the app. Markers with a decoded token ID keep
+// the same key whenever the token is seen, others are keyed by their
+// identifier.
+func (m *Marker) Key() int32 {
+	if m.TokenID >= 0 {
+		return int32(TokenKeyOffset + m.TokenID)
+	}
+	return int32(m.Identifier)
+}
+
+// predict moves the marker to where it is expected to be at the given time
+func (m *Marker) predict(at time.Time) {
+	m.filter.predict(at)
+	m.Position = m.filter.position()
+}
+
+// lose puts the marker back where it was last seen and stops it moving
+func (m *Marker) lose(at time.Time) {
+	m.Lost = true
+	m.LostAt = at
+	m.Position = m.observed
+	m.Velocity = gocv.Point2f{}
+}
+
+// observe updates the marker with a detection at the given time
+func (m *Marker) observe(d detection, at time.Time) {
+	if m.Lost {
+		// How the marker moved while it was out of sight is unknown, so
+		// start the filter afresh
+		m.Lost = false
+		m.filter = newMarkerFilter(d.position, at)
+	} else {
+		m.filter.predict(at)
+		m.filter.correct(d.position)
+	}
+	m.Position = m.filter.position()
+	m.Velocity = m.filter.velocity()
+	m.observed = m.Position
+	m.LastSeen = at
+
+	m.Area = d.area
+	m.Circularity = d.circularity
+	m.Brightness = d.brightness
+
+	m.colorCounts[d.color]++
+	if m.colorCounts[d.color] > m.colorCounts[m.Color] {
+		m.Color = d.color
+	}
+
+	m.observeHeading(d)
 }
 
+// matchesColor returns whether a detection of the given color could be this
+// marker. Unknown colors, e.g. from an overexposed LED, match any marker.
+func (m *Marker) matchesColor(color MarkerColor) bool {
+	return color == MarkerColorUnknown || m.Color == MarkerColorUnknown || color == m.Color
+}
+
+// MarkerSet is safe for concurrent use. The tracker holds its mutex while it
+// processes each frame, so the markers returned by GetMarkers are consistent
+// with a single frame.
 type MarkerSet struct {
+	mutex                sync.Mutex
 	availableIdentifiers []byte
 	markers              map[byte]*Marker
 }
@@ -31,30 +163,95 @@ func NewMarkerSet(maxSize int) *MarkerSet {
 	}
 }
 
-func (m *MarkerSet) AddMarker(marker Marker) byte {
-	// Pop the first available identifier
-	marker.Identifier = m.availableIdentifiers[0]
-	m.availableIdentifiers = m.availableIdentifiers[1:]
+// AddMarker adds the marker with the next free identifier. It fails if every
+// identifier is in use.
+func (m *MarkerSet) AddMarker(marker Marker) (byte, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
 
-	m.markers[marker.Identifier] = &marker
-	return marker.Identifier
+	return m.add(marker)
 }
 
 func (m *MarkerSet) RemoveMarker(identifier byte) {
-	m.availableIdentifiers = append(m.availableIdentifiers, identifier)
-	delete(m.markers, identifier)
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.remove(identifier)
 }
 
+// SetTokenID gives the marker the decoded token ID, taking it from any other
+// marker that had it, as a token can only be in one place
+func (m *MarkerSet) SetTokenID(identifier byte, tokenID int) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.setTokenID(identifier, tokenID)
+}
+
+// GetMarker returns a copy of the marker, or nil if there is no such marker
 func (m *MarkerSet) GetMarker(identifier int) *Marker {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
 	marker, ok := m.markers[byte(identifier)]
 	if !ok {
 		return nil
 	}
 
-	return marker
+	copied := *marker
+	return &copied
 }
 
+// GetMarkers returns copies of all the markers
 func (m *MarkerSet) GetMarkers() []*Marker {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	markers := m.all()
+	for i, marker := range markers {
+		copied := *marker
+		markers[i] = &copied
+	}
+	return markers
+}
+
+// The following must be called with the mutex held
+
+func (m *MarkerSet) add(marker Marker) (byte, error) {
+	if len(m.availableIdentifiers) == 0 {
+		return 0, fmt.Errorf("all %d marker identifiers are in use", len(m.markers))
+	}
+
+	// Pop the first available identifier
+	marker.Identifier = m.availableIdentifiers[0]
+	m.availableIdentifiers = m.availableIdentifiers[1:]
+
+	m.markers[marker.Identifier] = &marker
+	return marker.Identifier, nil
+}
+
+func (m *MarkerSet) remove(identifier byte) {
+	if _, ok := m.markers[identifier]; !ok {
+		return
+	}
+
+	m.availableIdentifiers = append(m.availableIdentifiers, identifier)
+	delete(m.markers, identifier)
+}
+
+func (m *MarkerSet) setTokenID(identifier byte, tokenID int) {
+	for _, marker := range m.markers {
+		if marker.TokenID == tokenID {
+			marker.TokenID = -1
+		}
+	}
+
+	if marker, ok := m.markers[identifier]; ok {
+		marker.TokenID = tokenID
+	}
+}
+
+func (m *MarkerSet) all() []*Marker {
 	markers := make([]*Marker, len(m.markers))
 	i := 0
 	for _, marker := range m.markers {
@@ -64,18 +261,3 @@ func (m *MarkerSet) GetMarkers() []*Marker {
 
 	return markers
 }
-
-// TODO: optimize this using a quadtree or similar if needed
-func (m *MarkerSet) FindClosestMarker(point image.Point, threshold float64) *Marker {
-	var closestMarker *Marker
-	var closestDistance float64
-	for _, marker := range m.markers {
-		distanceVector := marker.Position.Sub(point)
-		displacement := float64(distanceVector.X*distanceVector.X + distanceVector.Y*distanceVector.Y)
-		if displacement < threshold && (closestMarker == nil || displacement < closestDistance) {
-			closestMarker = marker
-			closestDistance = displacement
-		}
-	}
-	return closestMarker
-}
@@ -5,17 +5,27 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/tutman96/fantassist.io/tracker/pkg/calib3d"
 	"gocv.io/x/gocv"
 )
 
-func (t *Tracker) EstimatePose(ctx context.Context, cornersReal []gocv.Point3f, loopRate time.Duration) {
-	corners := gocv.NewMat()
-	defer corners.Close()
+// ReferenceMarker is an ArUco marker displayed at a known location on the table
+type ReferenceMarker struct {
+	ID       int
+	Position gocv.Point3f
+}
 
+// EstimatePose looks for the reference markers until the context is cancelled,
+// then computes the least-squares homography from the marker locations averaged
+// over the last few frames to their positions on the table. Markers that
+// weren't stable or don't agree with the rest are left out, but at least 4 are
+// needed. The calibration is rejected, leaving the previous one in place, if
+// the markers don't fit the homography well.
+func (t *Tracker) EstimatePose(ctx context.Context, references []ReferenceMarker, loopRate time.Duration) error {
+	frame := gocv.NewMat()
+	defer frame.Close()
 	invert := gocv.NewMat()
 	defer invert.Close()
-	output := gocv.NewMat()
-	t.debugFrames["pose"] = output
 
 	params := gocv.NewArucoDetectorParameters()
 	params.SetAdaptiveThreshConstant(5)
@@ -23,43 +33,21 @@ func (t *Tracker) EstimatePose(ctx context.Context, cornersReal []gocv.Point3f,
 	detector := gocv.NewArucoDetectorWithParams(dict, params)
 	defer detector.Close()
 
-	markers := make(map[int]gocv.Point2f)
+	observations := newCornerObservations()
 	ticker := time.NewTicker(loopRate)
 
 	for {
 		select {
 		case <-ctx.Done():
 			ticker.Stop()
-
-			if len(t.PoseCalibration.FoundCorners) < 4 {
-				fmt.Println("Not enough corners found", t.PoseCalibration.FoundCorners)
-				return
-			}
-
-			imagePoints := []gocv.Point2f{markers[0], markers[1], markers[2], markers[3]}
-			imageMat := gocv.NewMatWithSize(len(imagePoints), 1, gocv.MatTypeCV32FC2)
-			defer imageMat.Close()
-
-			for i, pt := range imagePoints {
-				imageMat.SetFloatAt(i, 0, pt.X)
-				imageMat.SetFloatAt(i, 1, pt.Y)
-			}
-
-			cornersMat := gocv.NewMatWithSize(4, 1, gocv.MatTypeCV32FC2)
-			defer cornersMat.Close()
-
-			for i, pt := range cornersReal {
-				cornersMat.SetFloatAt(i, 0, pt.X)
-				cornersMat.SetFloatAt(i, 1, pt.Y)
-			}
-
-			mask := gocv.NewMat()
-			homography := gocv.FindHomography(imageMat, &cornersMat, gocv.HomograpyMethodRANSAC, 3.0, &mask, 2000, 0.995)
-			t.PoseCalibration.HomographyMat = homography
-			return
+			return t.computePose(observations, references)
 		case <-ticker.C:
 			t.SetExposure(15000)
-			imgs := gocv.Split(t.frame)
+			t.copyFrame(&frame)
+			if frame.Empty() {
+				continue
+			}
+			imgs := gocv.Split(frame)
 			gocv.BitwiseNot(imgs[0], &invert)
 			for _, img := range imgs {
 				img.Close()
@@ -67,17 +55,167 @@ func (t *Tracker) EstimatePose(ctx context.Context, cornersReal []gocv.Point3f,
 
 			corners, markerIds, _ := detector.DetectMarkers(invert)
 
+			observations.nextFrame()
 			foundCorners := make([]int32, len(markerIds))
+			cornerLocations := make([]gocv.Point2f, len(markerIds))
+			cornerDeviations := make([]float64, len(markerIds))
 			for i, id := range markerIds {
-				markers[id-1] = corners[i][0]
+				observations.add(id, corners[i][0])
 				foundCorners[i] = int32(id)
+				cornerLocations[i], cornerDeviations[i], _ = observations.average(id)
 			}
 
 			fmt.Println("foundCorners", foundCorners)
-			fmt.Println("markers", markers)
 
-			t.PoseCalibration.FoundCorners = foundCorners
-			invert.CopyTo(&output)
+			t.mutex.Lock()
+			t.poseCalibration.FoundCorners = foundCorners
+			t.poseCalibration.CornerLocations = cornerLocations
+			t.poseCalibration.CornerDeviations = cornerDeviations
+			t.mutex.Unlock()
+			t.publishDebugFrame("pose", invert)
+		}
+	}
+}
+
+func (t *Tracker) computePose(observations *cornerObservations, references []ReferenceMarker) error {
+	markerIds := []int32{}
+	cornersReal := []gocv.Point3f{}
+	rawPoints := []gocv.Point2f{}
+	for _, reference := range references {
+		location, err := observations.stableCorner(reference.ID)
+		if err != nil {
+			fmt.Println("Leaving out marker:", err)
+			continue
+		}
+		markerIds = append(markerIds, int32(reference.ID))
+		cornersReal = append(cornersReal, reference.Position)
+		rawPoints = append(rawPoints, location)
+	}
+
+	if len(rawPoints) < 4 {
+		return fmt.Errorf("only %d of %d markers were found and steady, at least 4 are needed", len(rawPoints), len(references))
+	}
+
+	t.mutex.RLock()
+	imagePoints := make([]gocv.Point2f, len(rawPoints))
+	for i, pt := range rawPoints {
+		imagePoints[i] = t.intrinsics.UndistortPoint(pt)
+	}
+	t.mutex.RUnlock()
+
+	// RANSAC picks out any marker that doesn't agree with the rest, e.g. one
+	// that was placed in the wrong spot, and it is left out of the fit. Four
+	// points always fit exactly, so this only helps with more.
+	inliers := make([]bool, len(imagePoints))
+	for i := range inliers {
+		inliers[i] = true
+	}
+	if len(imagePoints) > 4 {
+		imageMat := pointsToMat(imagePoints)
+		defer imageMat.Close()
+		cornersMat := tablePointsToMat(cornersReal)
+		defer cornersMat.Close()
+
+		// The homography maps onto the table, so the threshold is in table
+		// units
+		mask := gocv.NewMat()
+		defer mask.Close()
+		ransac := gocv.FindHomography(imageMat, &cornersMat, gocv.HomograpyMethodRANSAC, MaxPoseReprojectionError, &mask, 2000, 0.995)
+		empty := ransac.Empty()
+		ransac.Close()
+		if empty {
+			return fmt.Errorf("unable to find homography")
 		}
+
+		for i := range inliers {
+			inliers[i] = mask.GetUCharAt(i, 0) != 0
+			if !inliers[i] {
+				fmt.Println("Leaving out marker", markerIds[i], "as it doesn't fit the homography")
+			}
+		}
+	}
+
+	inlierImagePoints := []gocv.Point2f{}
+	inlierRawPoints := []gocv.Point2f{}
+	inlierCornersReal := []gocv.Point3f{}
+	for i, inlier := range inliers {
+		if inlier {
+			inlierImagePoints = append(inlierImagePoints, imagePoints[i])
+			inlierRawPoints = append(inlierRawPoints, rawPoints[i])
+			inlierCornersReal = append(inlierCornersReal, cornersReal[i])
+		}
+	}
+
+	if len(inlierImagePoints) < 4 {
+		return fmt.Errorf("only %d of %d markers fit the homography, at least 4 are needed", len(inlierImagePoints), len(imagePoints))
+	}
+
+	imageMat := pointsToMat(inlierImagePoints)
+	defer imageMat.Close()
+	cornersMat := tablePointsToMat(inlierCornersReal)
+	defer cornersMat.Close()
+
+	noMask := gocv.NewMat()
+	defer noMask.Close()
+	homography := gocv.FindHomography(imageMat, &cornersMat, gocv.HomograpyMethodAllPoints, 0, &noMask, 2000, 0.995)
+	if homography.Empty() {
+		homography.Close()
+		return fmt.Errorf("unable to find homography")
+	}
+
+	// This only catches a bad calibration when there are more than 4 inliers,
+	// see MaxPoseReprojectionError
+	candidate := &calib3d.PoseCalibration{HomographyMat: homography}
+	reprojectionError := candidate.MeasureReprojectionError(inlierImagePoints, inlierCornersReal)
+	fmt.Println("Pose calibration reprojection error", reprojectionError)
+	if reprojectionError > MaxPoseReprojectionError {
+		homography.Close()
+		return fmt.Errorf("reprojection error of %.3f is above the limit of %.3f", reprojectionError, MaxPoseReprojectionError)
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.poseCalibration.HomographyMat.Close()
+	t.poseCalibration.HomographyMat = homography
+	t.poseCalibration.MarkerIds = markerIds
+	t.poseCalibration.RealCorners = cornersReal
+	t.poseCalibration.Resolution = t.resolution
+	t.poseCalibration.CalibratedAt = time.Now()
+	t.poseCalibration.InlierMask = inliers
+	t.poseCalibration.Residuals = candidate.MeasureResiduals(imagePoints, cornersReal)
+	t.poseCalibration.ReprojectionError = reprojectionError
+	t.poseCalibration.NeedsRecalibration = false
+
+	// The previous pose doesn't go with the new homography, so is dropped
+	// even if the new one can't be solved
+	t.poseCalibration.ClearExtrinsics()
+	if t.intrinsics != nil {
+		err := t.poseCalibration.SolveExtrinsics(inlierCornersReal, inlierRawPoints, t.intrinsics)
+		if err != nil {
+			fmt.Println("Unable to compute camera extrinsics, marker heights will be ignored:", err)
+		}
+	}
+	return nil
+}
+
+// pointsToMat packs pixel locations into a single column of 2 channel floats
+func pointsToMat(points []gocv.Point2f) gocv.Mat {
+	mat := gocv.NewMatWithSize(len(points), 1, gocv.MatTypeCV32FC2)
+	for i, pt := range points {
+		mat.SetFloatAt(i, 0, pt.X)
+		mat.SetFloatAt(i, 1, pt.Y)
+	}
+	return mat
+}
+
+// tablePointsToMat packs the x and y of points on the table into a single
+// column of 2 channel floats
+func tablePointsToMat(points []gocv.Point3f) gocv.Mat {
+	mat := gocv.NewMatWithSize(len(points), 1, gocv.MatTypeCV32FC2)
+	for i, pt := range points {
+		mat.SetFloatAt(i, 0, pt.X)
+		mat.SetFloatAt(i, 1, pt.Y)
 	}
+	return mat
 }
@@ -0,0 +1,110 @@
+package tracker
+
+import (
+	"fmt"
+	"math"
+
+	"gocv.io/x/gocv"
+)
+
+const (
+	// Corner locations are averaged over this many of the most recent frames
+	cornerObservationWindow = 20
+
+	// A corner has to be seen in at least this many frames of the window to be
+	// used, so a corner that is occluded part of the time isn't trusted
+	minCornerObservations = 10
+
+	// MaxCornerDeviation is the largest standard deviation, in pixels, of a
+	// corner's location across the window. Anything larger means the camera or
+	// the display moved while calibrating.
+	MaxCornerDeviation = 1.5
+
+	// MaxPoseReprojectionError is the largest RMS reprojection error, in table
+	// units, that a calibration can have before it is rejected. A homography
+	// fits any 4 points exactly, so with only 4 markers the error is always
+	// about 0 and only MaxCornerDeviation can reject a calibration.
+	MaxPoseReprojectionError = 0.25
+)
+
+type cornerObservation struct {
+	frame    int
+	location gocv.Point2f
+}
+
+// cornerObservations keeps a sliding window of where each corner marker has been
+// seen, so calibrations use a stable average rather than a single frame
+type cornerObservations struct {
+	frame        int
+	observations map[int][]cornerObservation
+}
+
+func newCornerObservations() *cornerObservations {
+	return &cornerObservations{
+		observations: make(map[int][]cornerObservation),
+	}
+}
+
+// nextFrame starts a new frame and forgets observations that have left the
+// window
+func (o *cornerObservations) nextFrame() {
+	o.frame++
+
+	for id, observations := range o.observations {
+		start := 0
+		for start < len(observations) && observations[start].frame <= o.frame-cornerObservationWindow {
+			start++
+		}
+		if start == len(observations) {
+			delete(o.observations, id)
+		} else {
+			o.observations[id] = observations[start:]
+		}
+	}
+}
+
+func (o *cornerObservations) add(id int, location gocv.Point2f) {
+	o.observations[id] = append(o.observations[id], cornerObservation{
+		frame:    o.frame,
+		location: location,
+	})
+}
+
+// average returns the mean location of the corner over the window, its
+// standard deviation in pixels and the number of frames it was seen in
+func (o *cornerObservations) average(id int) (gocv.Point2f, float64, int) {
+	observations := o.observations[id]
+	if len(observations) == 0 {
+		return gocv.Point2f{}, 0, 0
+	}
+
+	var sumX, sumY float64
+	for _, observation := range observations {
+		sumX += float64(observation.location.X)
+		sumY += float64(observation.location.Y)
+	}
+	n := float64(len(observations))
+	meanX, meanY := sumX/n, sumY/n
+
+	variance := 0.0
+	for _, observation := range observations {
+		dx := float64(observation.location.X) - meanX
+		dy := float64(observation.location.Y) - meanY
+		variance += dx*dx + dy*dy
+	}
+
+	return gocv.Point2f{X: float32(meanX), Y: float32(meanY)}, math.Sqrt(variance / n), len(observations)
+}
+
+// stableCorner returns the average location of the corner, or an error if it
+// hasn't been seen often enough or moved too much to be trusted
+func (o *cornerObservations) stableCorner(id int) (gocv.Point2f, error) {
+	location, deviation, count := o.average(id)
+	if count < minCornerObservations {
+		return location, fmt.Errorf("corner %d was only seen in %d of the last %d frames", id, count, cornerObservationWindow)
+	}
+	if deviation > MaxCornerDeviation {
+		return location, fmt.Errorf("corner %d moved %.1f pixels while calibrating", id, deviation)
+	}
+	return location, nil
+}
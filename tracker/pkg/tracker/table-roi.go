@@ -0,0 +1,117 @@
+package tracker
+
+import (
+	"image"
+	"image/color"
+	"time"
+
+	"github.com/tutman96/fantassist.io/tracker/pkg/calib3d"
+	"gocv.io/x/gocv"
+)
+
+const (
+	// DefaultTableMargin is how far, in table units, outside the calibrated
+	// table markers are still tracked, unless the tracker is told otherwise.
+	// The reference markers are displayed a little in from the table's edges.
+	DefaultTableMargin = 0.5
+
+	// Each edge of the table is drawn as this many segments, so the lens's
+	// distortion of the edges is followed
+	tableEdgeSegments = 16
+)
+
+// tableROI masks everything but the table out of the frames, so room lights
+// and screens beside the table aren't tracked. It is rebuilt whenever the
+// calibration or margin changes.
+type tableROI struct {
+	mask gocv.Mat
+
+	calibratedAt time.Time
+	intrinsics   *calib3d.CameraIntrinsics
+	margin       float64
+}
+
+func newTableROI() *tableROI {
+	return &tableROI{mask: gocv.NewMat()}
+}
+
+func (r *tableROI) close() {
+	r.mask.Close()
+}
+
+// refreshTableROI rebuilds the region of interest for frames of the given size
+// if the calibration or margin has changed, and returns whether there is one to
+// apply. There is none until the pose has been calibrated.
+func (t *Tracker) refreshTableROI(roi *tableROI, size image.Point) bool {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	min, max, ok := t.poseCalibration.TableBounds()
+	if !ok || t.poseCalibration.HomographyMat.Empty() {
+		return false
+	}
+
+	if roi.mask.Cols() == size.X && roi.mask.Rows() == size.Y &&
+		roi.calibratedAt.Equal(t.poseCalibration.CalibratedAt) &&
+		roi.intrinsics == t.intrinsics &&
+		roi.margin == t.tableMargin {
+		return true
+	}
+
+	margin := float32(t.tableMargin)
+	corners := []gocv.Point2f{
+		{X: min.X - margin, Y: min.Y - margin},
+		{X: max.X + margin, Y: min.Y - margin},
+		{X: max.X + margin, Y: max.Y + margin},
+		{X: min.X - margin, Y: max.Y + margin},
+	}
+
+	outline := []image.Point{}
+	for i, from := range corners {
+		to := corners[(i+1)%len(corners)]
+		for s := 0; s < tableEdgeSegments; s++ {
+			fraction := float32(s) / tableEdgeSegments
+			point := gocv.Point2f{
+				X: from.X + (to.X-from.X)*fraction,
+				Y: from.Y + (to.Y-from.Y)*fraction,
+			}
+
+			pixel := t.intrinsics.DistortPoint(t.poseCalibration.TableToPixel(point))
+			outline = append(outline, image.Pt(int(pixel.X+0.5), int(pixel.Y+0.5)))
+		}
+	}
+
+	roi.mask.Close()
+	roi.mask = gocv.Zeros(size.Y, size.X, gocv.MatTypeCV8U)
+	polygon := gocv.NewPointsVectorFromPoints([][]image.Point{outline})
+	gocv.FillPoly(&roi.mask, polygon, color.RGBA{R: 255, G: 255, B: 255})
+	polygon.Close()
+
+	roi.calibratedAt = t.poseCalibration.CalibratedAt
+	roi.intrinsics = t.intrinsics
+	roi.margin = t.tableMargin
+	return true
+}
+
+// clipDetections drops detections that are outside the table, allowing for
+// the margin. Nothing is dropped until the pose has been calibrated.
+func (t *Tracker) clipDetections(detections []detection) []detection {
+	t.mutex.RLock()
+	min, max, ok := t.poseCalibration.TableBounds()
+	margin := float32(t.tableMargin)
+	t.mutex.RUnlock()
+
+	if !ok || !t.isPoseCalibrated() {
+		return detections
+	}
+
+	kept := []detection{}
+	for _, d := range detections {
+		point := t.ConvertPixelTo3D(d.position)
+		if point.X < min.X-margin || point.X > max.X+margin || point.Y < min.Y-margin || point.Y > max.Y+margin {
+			continue
+		}
+		kept = append(kept, d)
+	}
+	return kept
+}
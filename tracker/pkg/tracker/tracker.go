@@ -4,69 +4,117 @@ import (
 	"fmt"
 	"image"
 	"image/color"
+	"math"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/tutman96/fantassist.io/tracker/pkg/calib3d"
-	"github.com/tutman96/fantassist.io/tracker/pkg/libcamera"
 	"gocv.io/x/gocv"
 )
 
+// FrameSource supplies the frames that the tracker processes, e.g. a camera.
+// Start delivers frames on Frames until Stop is called or it fails, and closes
+// Frames when it returns.
+type FrameSource interface {
+	Start() error
+	Stop()
+	SetExposure(microseconds int)
+	GetExposure() int
+	Frames() <-chan gocv.Mat
+}
+
 type Tracker struct {
-	resolution      image.Point
-	camera          *libcamera.Camera
-	framesChan      chan libcamera.Frame
-	frame           gocv.Mat
-	frameListeners  []chan gocv.Mat
-	frameDuration   time.Duration
-	PoseCalibration *calib3d.PoseCalibration
+	resolution image.Point
+	camera     FrameSource
+
+	// frameMutex guards the latest frame from the camera, how long it took to
+	// arrive, and why the camera stopped if it failed
+	frameMutex    sync.RWMutex
+	frame         gocv.Mat
+	frameDuration time.Duration
+	cameraError   error
 
-	markerListeners []chan []*Marker
-	markers         *MarkerSet
+	// mutex guards the calibrations, tracking and detection options, display
+	// mask and listeners
+	mutex                  sync.RWMutex
+	poseCalibration        *calib3d.PoseCalibration
+	intrinsics             *calib3d.CameraIntrinsics
+	intrinsicViewsCaptured int
+	markerHeight           float64
+	lostGracePeriod        time.Duration
+	tableMargin            float64
+	ledPairHeadings        bool
+	detectionParameters    DetectionParameters
+	backgroundResets       int
+	displayMask            *displayMask
+	frameListeners         []chan struct{}
+	markerEventListeners   []chan MarkerEvent
 
-	debugFrames map[string]gocv.Mat
+	markers *MarkerSet
+
+	debugFramesMutex sync.Mutex
+	debugFrames      map[string]*debugFrame
 }
 
-func NewTracker(resolution image.Point, poseCalibration *calib3d.PoseCalibration) (*Tracker, error) {
+// debugFrame is an image shown by HandleMJPEG, updated as the tracker works
+type debugFrame struct {
+	mutex sync.Mutex
+	mat   gocv.Mat
+}
+
+func NewTracker(resolution image.Point, camera FrameSource, poseCalibration *calib3d.PoseCalibration, intrinsics *calib3d.CameraIntrinsics) *Tracker {
 	if poseCalibration == nil {
 		poseCalibration = calib3d.NewPoseCalibration()
+	} else if intrinsics != nil && !poseCalibration.HomographyMat.Empty() && poseCalibration.CalibratedAt.Before(intrinsics.CalibratedAt) {
+		fmt.Println("Camera intrinsics are newer than the pose calibration, calibration is required before tracking")
+		poseCalibration.Invalidate()
 	}
 
-	t := &Tracker{
-		resolution:      resolution,
-		framesChan:      make(chan libcamera.Frame),
-		markers:         NewMarkerSet(255),
-		debugFrames:     make(map[string]gocv.Mat),
-		PoseCalibration: poseCalibration,
-	}
-
-	c, err := libcamera.Open(t.resolution, t.framesChan)
-	if err != nil {
-		return nil, err
+	return &Tracker{
+		resolution:          resolution,
+		camera:              camera,
+		frame:               gocv.NewMat(),
+		markers:             NewMarkerSet(255),
+		debugFrames:         make(map[string]*debugFrame),
+		poseCalibration:     poseCalibration,
+		intrinsics:          intrinsics,
+		lostGracePeriod:     DefaultLostGracePeriod,
+		tableMargin:         DefaultTableMargin,
+		detectionParameters: DefaultDetectionParameters,
 	}
-	t.camera = c
-
-	return t, nil
 }
 
 func (t *Tracker) StartCapture() {
-	go t.camera.Start()
+	go func() {
+		err := t.camera.Start()
+		if err != nil {
+			fmt.Println("Camera stopped:", err)
+
+			t.frameMutex.Lock()
+			t.cameraError = err
+			t.frameMutex.Unlock()
+		}
+	}()
 	go func() {
 		lastDetection := time.Now()
-		for frame := range t.framesChan {
+		for f := range t.camera.Frames() {
+			// The camera may reuse f for the next frame, so keep a copy
+			t.frameMutex.Lock()
 			t.frameDuration = time.Since(lastDetection)
 			lastDetection = time.Now()
+			f.CopyTo(&t.frame)
+			t.frameMutex.Unlock()
 
-			f := frame.Image()
-			t.frame = f
-			t.debugFrames["raw"] = f
+			t.mutex.RLock()
 			for _, listener := range t.frameListeners {
 				select {
-				case listener <- t.frame:
+				case listener <- struct{}{}:
 				default:
 					// noop
 				}
 			}
+			t.mutex.RUnlock()
 		}
 	}()
 }
@@ -75,16 +123,157 @@ func (t *Tracker) StopCapture() {
 	t.camera.Stop()
 }
 
+// CameraError returns why the camera stopped delivering frames, or nil if it
+// hasn't failed
+func (t *Tracker) CameraError() error {
+	t.frameMutex.RLock()
+	defer t.frameMutex.RUnlock()
+
+	return t.cameraError
+}
+
 func (t *Tracker) SetExposure(microseconds int) {
 	t.camera.SetExposure(microseconds)
 }
 
+// GetMarkers returns copies of the markers as of the last processed frame
 func (t *Tracker) GetMarkers() []*Marker {
 	return t.markers.GetMarkers()
 }
 
-func (t *Tracker) ConvertPixelTo3D(pixel image.Point) gocv.Point3f {
-	return t.PoseCalibration.PixelTo3D(pixel, 0) // TODO: configure height offset
+// GetPoseCalibration returns a deep copy of the pose calibration, which the
+// caller has to close
+func (t *Tracker) GetPoseCalibration() *calib3d.PoseCalibration {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.poseCalibration.Clone()
+}
+
+// SavePoseCalibration writes the pose calibration to path
+func (t *Tracker) SavePoseCalibration(path string) error {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.poseCalibration.Save(path)
+}
+
+// GetIntrinsics returns the camera intrinsics, or nil if the camera's lens
+// hasn't been calibrated
+func (t *Tracker) GetIntrinsics() *calib3d.CameraIntrinsics {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.intrinsics
+}
+
+// IntrinsicViewsCaptured returns how many views of the checkerboard the
+// current intrinsic calibration has captured
+func (t *Tracker) IntrinsicViewsCaptured() int {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.intrinsicViewsCaptured
+}
+
+// SetIntrinsics replaces the camera intrinsics used to undistort pixels. Any
+// pose calibration is invalidated, as its homography was computed from pixels
+// undistorted with the previous intrinsics, so the pose has to be recalibrated
+// before tracking.
+func (t *Tracker) SetIntrinsics(intrinsics *calib3d.CameraIntrinsics) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.intrinsics != nil {
+		t.intrinsics.Close()
+	}
+	t.intrinsics = intrinsics
+
+	if !t.poseCalibration.HomographyMat.Empty() {
+		t.poseCalibration.Invalidate()
+	}
+}
+
+// SetTrackingOptions sets how far above the table the markers' LEDs are, how
+// long markers that can't be seen keep their identity before they are removed,
+// and how far outside the calibrated table markers are still tracked
+func (t *Tracker) SetTrackingOptions(markerHeight float64, lostGracePeriod time.Duration, tableMargin float64) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.markerHeight = markerHeight
+	t.lostGracePeriod = lostGracePeriod
+	t.tableMargin = tableMargin
+}
+
+// SetLEDPairHeadings sets whether markers of the PairFrontColor and
+// PairBackColor that are close together are paired up as two LED bases, see
+// pairLEDs
+func (t *Tracker) SetLEDPairHeadings(enabled bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.ledPairHeadings = enabled
+}
+
+func (t *Tracker) ConvertPixelTo3D(pixel gocv.Point2f) gocv.Point3f {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	undistorted := t.intrinsics.UndistortPoint(pixel)
+	return t.poseCalibration.PixelTo3D(undistorted, t.markerHeight)
+}
+
+// isPoseCalibrated returns whether pixels can be mapped onto the table
+func (t *Tracker) isPoseCalibrated() bool {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return !t.poseCalibration.HomographyMat.Empty()
+}
+
+// copyFrame copies the latest frame from the camera into dst
+func (t *Tracker) copyFrame(dst *gocv.Mat) {
+	t.frameMutex.RLock()
+	defer t.frameMutex.RUnlock()
+
+	t.frame.CopyTo(dst)
+}
+
+// publishDebugFrame copies frame to the debug frame with the given name
+func (t *Tracker) publishDebugFrame(name string, frame gocv.Mat) {
+	t.debugFramesMutex.Lock()
+	debug, ok := t.debugFrames[name]
+	if !ok {
+		debug = &debugFrame{mat: gocv.NewMat()}
+		t.debugFrames[name] = debug
+	}
+	t.debugFramesMutex.Unlock()
+
+	debug.mutex.Lock()
+	defer debug.mutex.Unlock()
+	frame.CopyTo(&debug.mat)
+}
+
+// copyDebugFrame copies the debug frame with the given name into dst. The raw
+// frame is the latest frame from the camera.
+func (t *Tracker) copyDebugFrame(name string, dst *gocv.Mat) bool {
+	if name == "raw" {
+		t.copyFrame(dst)
+		return true
+	}
+
+	t.debugFramesMutex.Lock()
+	debug, ok := t.debugFrames[name]
+	t.debugFramesMutex.Unlock()
+	if !ok {
+		return false
+	}
+
+	debug.mutex.Lock()
+	defer debug.mutex.Unlock()
+	debug.mat.CopyTo(dst)
+	return true
 }
 
 func (t *Tracker) HandleMJPEG(w http.ResponseWriter, r *http.Request) {
@@ -96,28 +285,43 @@ func (t *Tracker) HandleMJPEG(w http.ResponseWriter, r *http.Request) {
 		frameName = "raw"
 	}
 
-	frame, ok := t.debugFrames[frameName]
-	if !ok {
+	output := gocv.NewMat()
+	defer output.Close()
+
+	if !t.copyDebugFrame(frameName, &output) {
 		http.Error(w, "Frame not found", http.StatusNotFound)
 		return
 	}
 
 	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-time.After(100 * time.Millisecond): // 10 fps
+		}
+
 		now := time.Now()
 
-		if frame.Empty() {
-			time.Sleep(100 * time.Millisecond) // 10 fps
+		t.copyDebugFrame(frameName, &output)
+		if output.Empty() {
 			continue
 		}
 
-		output := frame.Clone()
-		gocv.PutText(&output, fmt.Sprintf("%v", t.frameDuration), image.Pt(0, 11), gocv.FontHersheySimplex, 0.5, color.RGBA{B: 255}, 2)
+		t.frameMutex.RLock()
+		frameDuration := t.frameDuration
+		t.frameMutex.RUnlock()
+		gocv.PutText(&output, fmt.Sprintf("%v", frameDuration), image.Pt(0, 11), gocv.FontHersheySimplex, 0.5, color.RGBA{B: 255}, 2)
 
 		markers := t.markers.GetMarkers()
 		for _, marker := range markers {
 			c := color.RGBA{G: 255 - uint8(255*float32(now.Sub(marker.LastSeen).Seconds())/5)}
-			gocv.Circle(&output, marker.Position, 15, c, 1)
-			gocv.PutText(&output, fmt.Sprintf("%d", marker.Identifier), marker.Position.Add(image.Pt(-5, 3)), gocv.FontHersheySimplex, 0.5, c, 1)
+			position := image.Pt(int(marker.Position.X+0.5), int(marker.Position.Y+0.5))
+			gocv.Circle(&output, position, 15, c, 1)
+			if marker.HasHeading {
+				facing := position.Add(image.Pt(int(15*math.Cos(marker.Heading)), int(15*math.Sin(marker.Heading))))
+				gocv.Line(&output, position, facing, c, 2)
+			}
+			gocv.PutText(&output, fmt.Sprintf("%d", marker.Key()), position.Add(image.Pt(-5, 3)), gocv.FontHersheySimplex, 0.5, c, 1)
 		}
 
 		jpegFrame, _ := gocv.IMEncode(".jpg", output)
@@ -126,24 +330,32 @@ func (t *Tracker) HandleMJPEG(w http.ResponseWriter, r *http.Request) {
 		w.Write(jpegFrame.GetBytes())
 		fmt.Fprintf(w, "\r\n")
 		jpegFrame.Close()
-		output.Close()
-		time.Sleep(100 * time.Millisecond) // 10 fps
 	}
 }
 
-func (t *Tracker) registerFrameListener() (chan gocv.Mat, func()) {
+// registerFrameListener returns a channel that is signalled whenever a new
+// frame arrives, and a function to stop the signals. Signals are dropped while
+// the listener is busy.
+func (t *Tracker) registerFrameListener() (chan struct{}, func()) {
 	fmt.Println("registering frame listener")
-	listener := make(chan gocv.Mat)
+	listener := make(chan struct{}, 1)
+
+	t.mutex.Lock()
 	t.frameListeners = append(t.frameListeners, listener)
+	t.mutex.Unlock()
 
 	return listener, func() {
 		fmt.Println("deregistering frame listener")
-		close(listener)
+
+		t.mutex.Lock()
 		for i, l := range t.frameListeners {
 			if l == listener {
 				t.frameListeners = append(t.frameListeners[:i], t.frameListeners[i+1:]...)
 				break
 			}
 		}
+		t.mutex.Unlock()
+
+		close(listener)
 	}
 }
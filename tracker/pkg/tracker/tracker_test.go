@@ -0,0 +1,165 @@
+package tracker
+
+import (
+	"context"
+	"image"
+	"math"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tutman96/fantassist.io/tracker/pkg/synthetic"
+	"gocv.io/x/gocv"
+)
+
+// newTestCamera looks straight down at a 32x24 table that fills most of the
+// frame, with two stationary LEDs and one circling the middle of the table
+func newTestCamera(resolution image.Point) *synthetic.Camera {
+	scene := synthetic.Scene{
+		Width:            32,
+		Height:           24,
+		CornerMarkerSize: 3,
+		CornerPadding:    1,
+		Tokens: []synthetic.Token{
+			{ID: 1, Path: synthetic.Stationary(gocv.Point2f{X: 8, Y: 6}), Radius: 0.75},
+			{ID: 2, Path: synthetic.Stationary(gocv.Point2f{X: 24, Y: 18}), Radius: 0.75},
+			{ID: 3, Path: synthetic.Circular(gocv.Point2f{X: 16, Y: 12}, 6, 2*time.Second), Radius: 0.75},
+		},
+		Camera: synthetic.CameraPose{
+			Position:    gocv.Point3f{X: 16, Y: 12, Z: 30},
+			FocalLength: 240,
+		},
+	}
+
+	return synthetic.NewCamera(scene, resolution, 100)
+}
+
+func TestMarkerSetConcurrentAccess(t *testing.T) {
+	markers := NewMarkerSet(255)
+
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+
+			for i := 0; i < 200; i++ {
+				identifier, err := markers.AddMarker(Marker{TokenID: -1})
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				markers.SetTokenID(identifier, (w*200+i)%64)
+				markers.GetMarker(int(identifier))
+				for _, marker := range markers.GetMarkers() {
+					marker.Key()
+				}
+				markers.RemoveMarker(identifier)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	if remaining := len(markers.GetMarkers()); remaining != 0 {
+		t.Errorf("expected no markers to remain, found %d", remaining)
+	}
+}
+
+func TestMarkerSetFull(t *testing.T) {
+	markers := NewMarkerSet(2)
+	for i := 0; i < 2; i++ {
+		if _, err := markers.AddMarker(Marker{TokenID: -1}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := markers.AddMarker(Marker{TokenID: -1}); err == nil {
+		t.Errorf("expected adding a marker to a full set to fail")
+	}
+
+	markers.RemoveMarker(1)
+	if identifier, err := markers.AddMarker(Marker{TokenID: -1}); err != nil || identifier != 1 {
+		t.Errorf("expected the removed marker's identifier to be reused, got %d, %v", identifier, err)
+	}
+}
+
+func TestTrackerConcurrentAccess(t *testing.T) {
+	resolution := image.Pt(320, 240)
+	camera := newTestCamera(resolution)
+	tr := NewTracker(resolution, camera, nil, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	tr.StartCapture()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		tr.DetectMarkers(ctx)
+	}()
+
+	readers := []func(){
+		func() {
+			for _, marker := range tr.GetMarkers() {
+				tr.ConvertPixelTo3D(marker.Position)
+			}
+		},
+		func() {
+			calibration := tr.GetPoseCalibration()
+			_ = calibration.FoundCorners
+			calibration.Close()
+			tr.IntrinsicViewsCaptured()
+		},
+		func() {
+			_, deregister := tr.RegisterMarkerEventListener()
+			deregister()
+		},
+		func() {
+			tr.SetTrackingOptions(1, DefaultLostGracePeriod, DefaultTableMargin)
+			tr.SetIntrinsics(nil)
+		},
+		func() {
+			req := httptest.NewRequest("GET", "/mjpeg?frame=markers", nil)
+			reqCtx, reqCancel := context.WithTimeout(req.Context(), 150*time.Millisecond)
+			defer reqCancel()
+			tr.HandleMJPEG(httptest.NewRecorder(), req.WithContext(reqCtx))
+		},
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for _, reader := range readers {
+		wg.Add(1)
+		go func(reader func()) {
+			defer wg.Done()
+
+			for time.Now().Before(deadline) {
+				reader()
+				time.Sleep(time.Millisecond)
+			}
+		}(reader)
+	}
+
+	time.Sleep(time.Until(deadline))
+	cancel()
+	wg.Wait()
+
+	markers := tr.GetMarkers()
+	truth := camera.GroundTruth()
+	tr.StopCapture()
+
+	// Only the stationary LEDs are compared, as the circling one moves between
+	// the frame the markers were detected in and the latest ground truth
+	for _, token := range truth.Tokens[:2] {
+		closest := math.Inf(1)
+		for _, marker := range markers {
+			dx := float64(marker.Position.X - token.Pixel.X)
+			dy := float64(marker.Position.Y - token.Pixel.Y)
+			closest = math.Min(closest, math.Sqrt(dx*dx+dy*dy))
+		}
+
+		if closest > 2 {
+			t.Errorf("expected a marker at the LED of token %d at %v, closest was %.1f pixels away", token.ID, token.Pixel, closest)
+		}
+	}
+}
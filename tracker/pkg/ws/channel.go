@@ -0,0 +1,171 @@
+package ws
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	"github.com/tutman96/fantassist.io/tracker/pkg/channel"
+	"github.com/tutman96/fantassist.io/tracker/protos"
+	"google.golang.org/protobuf/proto"
+)
+
+// WebSocketChannel carries packets over WebSocket connections, one marshalled
+// protos.Packet per binary message. It is mounted on the tracker's HTTP server
+// so clients that can't use Web Bluetooth can connect over the network.
+type WebSocketChannel struct {
+	upgrader   websocket.Upgrader
+	dispatcher *channel.Dispatcher
+
+	mutex               sync.Mutex
+	connections         map[*connection]struct{}
+	connectionListeners channel.ConnectionListeners
+}
+
+type connection struct {
+	conn       *websocket.Conn
+	writeMutex sync.Mutex
+}
+
+func (c *connection) send(packet *protos.Packet) error {
+	bytes, err := proto.Marshal(packet)
+	if err != nil {
+		return err
+	}
+
+	c.writeMutex.Lock()
+	defer c.writeMutex.Unlock()
+	return c.conn.WriteMessage(websocket.BinaryMessage, bytes)
+}
+
+// NewWebSocketChannel creates a channel that accepts connections from pages
+// served from one of allowedOrigins, e.g. "https://app.fantassist.io", and from
+// clients that aren't browsers, which don't send an Origin
+func NewWebSocketChannel(allowedOrigins []string) *WebSocketChannel {
+	return &WebSocketChannel{
+		upgrader: websocket.Upgrader{
+			// The web app is served from a different origin than the tracker
+			CheckOrigin: func(r *http.Request) bool {
+				origin := r.Header.Get("Origin")
+				return origin == "" || slices.Contains(allowedOrigins, origin)
+			},
+		},
+		dispatcher:  channel.NewDispatcher(),
+		connections: make(map[*connection]struct{}),
+	}
+}
+
+func (manager *WebSocketChannel) Start(ctx context.Context) error {
+	go func() {
+		<-ctx.Done()
+
+		manager.mutex.Lock()
+		defer manager.mutex.Unlock()
+		for c := range manager.connections {
+			c.conn.Close()
+		}
+	}()
+
+	return nil
+}
+
+func (manager *WebSocketChannel) OnConnectionStateChange() <-chan bool {
+	return manager.connectionListeners.Add()
+}
+
+func (manager *WebSocketChannel) AddRequestHandler(handler channel.RequestHandler) {
+	manager.dispatcher.AddRequestHandler(handler)
+}
+
+func (manager *WebSocketChannel) Request(req *protos.Request) *protos.Response {
+	packet, response := manager.dispatcher.NewRequest(req)
+	manager.SendPacket(packet)
+	return <-response
+}
+
+// SendPacket sends the packet to every connected client
+func (manager *WebSocketChannel) SendPacket(packet *protos.Packet) {
+	manager.mutex.Lock()
+	connections := make([]*connection, 0, len(manager.connections))
+	for c := range manager.connections {
+		connections = append(connections, c)
+	}
+	manager.mutex.Unlock()
+
+	fmt.Println("-> Sending packet", packet, "to", len(connections), "websocket clients")
+	for _, c := range connections {
+		err := c.send(packet)
+		if err != nil {
+			fmt.Println("Error sending packet:", err)
+		}
+	}
+}
+
+func (manager *WebSocketChannel) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	conn, err := manager.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		fmt.Println("Error upgrading websocket connection:", err)
+		return
+	}
+
+	c := &connection{conn: conn}
+	manager.addConnection(c)
+	defer manager.removeConnection(c)
+
+	for {
+		messageType, value, err := conn.ReadMessage()
+		if err != nil {
+			fmt.Println("Websocket connection closed:", err)
+			return
+		}
+		if messageType != websocket.BinaryMessage {
+			continue
+		}
+
+		packet := &protos.Packet{}
+		err = proto.Unmarshal(value, packet)
+		if err != nil {
+			fmt.Println("Error unmarshalling packet:", err)
+			continue
+		}
+
+		fmt.Println("<- Received packet", packet)
+
+		// Respond directly to the client that made the request
+		response := manager.dispatcher.HandlePacket(packet)
+		if response != nil {
+			err = c.send(response)
+			if err != nil {
+				fmt.Println("Error sending response:", err)
+			}
+		}
+	}
+}
+
+func (manager *WebSocketChannel) addConnection(c *connection) {
+	manager.mutex.Lock()
+	manager.connections[c] = struct{}{}
+	first := len(manager.connections) == 1
+	manager.mutex.Unlock()
+
+	fmt.Println("Websocket client connected from", c.conn.RemoteAddr())
+	if first {
+		manager.connectionListeners.Notify(true)
+	}
+}
+
+func (manager *WebSocketChannel) removeConnection(c *connection) {
+	c.conn.Close()
+
+	manager.mutex.Lock()
+	delete(manager.connections, c)
+	last := len(manager.connections) == 0
+	manager.mutex.Unlock()
+
+	if last {
+		manager.connectionListeners.Notify(false)
+	}
+}
@@ -0,0 +1,37 @@
+package ws
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestWebSocketChannelOrigins(t *testing.T) {
+	server := httptest.NewServer(NewWebSocketChannel([]string{"https://app.fantassist.io"}))
+	defer server.Close()
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	for origin, allowed := range map[string]bool{
+		"":                          true,
+		"https://app.fantassist.io": true,
+		"https://example.com":       false,
+	} {
+		header := http.Header{}
+		if origin != "" {
+			header.Set("Origin", origin)
+		}
+
+		conn, _, err := websocket.DefaultDialer.Dial(url, header)
+		if conn != nil {
+			conn.Close()
+		}
+		if allowed && err != nil {
+			t.Errorf("expected origin %q to be allowed, got %v", origin, err)
+		} else if !allowed && err == nil {
+			t.Errorf("expected origin %q to be rejected", origin)
+		}
+	}
+}
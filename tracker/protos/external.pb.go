@@ -20,12 +20,67 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Color of a marker's LED. Values are prefixed as enum values share the
+// package's scope.
+type TrackerMarkerColor int32
+
+const (
+	TrackerMarkerColor_COLOR_UNKNOWN TrackerMarkerColor = 0
+	TrackerMarkerColor_COLOR_RED     TrackerMarkerColor = 1
+	TrackerMarkerColor_COLOR_GREEN   TrackerMarkerColor = 2
+	TrackerMarkerColor_COLOR_BLUE    TrackerMarkerColor = 3
+)
+
+// Enum value maps for TrackerMarkerColor.
+var (
+	TrackerMarkerColor_name = map[int32]string{
+		0: "COLOR_UNKNOWN",
+		1: "COLOR_RED",
+		2: "COLOR_GREEN",
+		3: "COLOR_BLUE",
+	}
+	TrackerMarkerColor_value = map[string]int32{
+		"COLOR_UNKNOWN": 0,
+		"COLOR_RED":     1,
+		"COLOR_GREEN":   2,
+		"COLOR_BLUE":    3,
+	}
+)
+
+func (x TrackerMarkerColor) Enum() *TrackerMarkerColor {
+	p := new(TrackerMarkerColor)
+	*p = x
+	return p
+}
+
+func (x TrackerMarkerColor) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrackerMarkerColor) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_external_proto_enumTypes[0].Descriptor()
+}
+
+func (TrackerMarkerColor) Type() protoreflect.EnumType {
+	return &file_protos_external_proto_enumTypes[0]
+}
+
+func (x TrackerMarkerColor) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrackerMarkerColor.Descriptor instead.
+func (TrackerMarkerColor) EnumDescriptor() ([]byte, []int) {
+	return file_protos_external_proto_rawDescGZIP(), []int{0}
+}
+
 type TrackerGetStatusResponse_TrackerState int32
 
 const (
-	TrackerGetStatusResponse_IDLE        TrackerGetStatusResponse_TrackerState = 0
-	TrackerGetStatusResponse_CALIBRATING TrackerGetStatusResponse_TrackerState = 1
-	TrackerGetStatusResponse_TRACKING    TrackerGetStatusResponse_TrackerState = 2
+	TrackerGetStatusResponse_IDLE                   TrackerGetStatusResponse_TrackerState = 0
+	TrackerGetStatusResponse_CALIBRATING            TrackerGetStatusResponse_TrackerState = 1
+	TrackerGetStatusResponse_TRACKING               TrackerGetStatusResponse_TrackerState = 2
+	TrackerGetStatusResponse_CALIBRATING_INTRINSICS TrackerGetStatusResponse_TrackerState = 3
 )
 
 // Enum value maps for TrackerGetStatusResponse_TrackerState.
@@ -34,11 +89,13 @@ var (
 		0: "IDLE",
 		1: "CALIBRATING",
 		2: "TRACKING",
+		3: "CALIBRATING_INTRINSICS",
 	}
 	TrackerGetStatusResponse_TrackerState_value = map[string]int32{
-		"IDLE":        0,
-		"CALIBRATING": 1,
-		"TRACKING":    2,
+		"IDLE":                   0,
+		"CALIBRATING":            1,
+		"TRACKING":               2,
+		"CALIBRATING_INTRINSICS": 3,
 	}
 )
 
@@ -53,11 +110,11 @@ func (x TrackerGetStatusResponse_TrackerState) String() string {
 }
 
 func (TrackerGetStatusResponse_TrackerState) Descriptor() protoreflect.EnumDescriptor {
-	return file_protos_external_proto_enumTypes[0].Descriptor()
+	return file_protos_external_proto_enumTypes[1].Descriptor()
 }
 
 func (TrackerGetStatusResponse_TrackerState) Type() protoreflect.EnumType {
-	return &file_protos_external_proto_enumTypes[0]
+	return &file_protos_external_proto_enumTypes[1]
 }
 
 func (x TrackerGetStatusResponse_TrackerState) Number() protoreflect.EnumNumber {
@@ -69,6 +126,226 @@ func (TrackerGetStatusResponse_TrackerState) EnumDescriptor() ([]byte, []int) {
 	return file_protos_external_proto_rawDescGZIP(), []int{14, 0}
 }
 
+type TrackerGetCalibrationResponse_CalibrationResult int32
+
+const (
+	// Calibration hasn't finished since it was started
+	TrackerGetCalibrationResponse_NONE   TrackerGetCalibrationResponse_CalibrationResult = 0
+	TrackerGetCalibrationResponse_PASSED TrackerGetCalibrationResponse_CalibrationResult = 1
+	// The calibration was rejected and the previous calibration kept, see
+	// failureReason
+	TrackerGetCalibrationResponse_FAILED TrackerGetCalibrationResponse_CalibrationResult = 2
+)
+
+// Enum value maps for TrackerGetCalibrationResponse_CalibrationResult.
+var (
+	TrackerGetCalibrationResponse_CalibrationResult_name = map[int32]string{
+		0: "NONE",
+		1: "PASSED",
+		2: "FAILED",
+	}
+	TrackerGetCalibrationResponse_CalibrationResult_value = map[string]int32{
+		"NONE":   0,
+		"PASSED": 1,
+		"FAILED": 2,
+	}
+)
+
+func (x TrackerGetCalibrationResponse_CalibrationResult) Enum() *TrackerGetCalibrationResponse_CalibrationResult {
+	p := new(TrackerGetCalibrationResponse_CalibrationResult)
+	*p = x
+	return p
+}
+
+func (x TrackerGetCalibrationResponse_CalibrationResult) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrackerGetCalibrationResponse_CalibrationResult) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_external_proto_enumTypes[2].Descriptor()
+}
+
+func (TrackerGetCalibrationResponse_CalibrationResult) Type() protoreflect.EnumType {
+	return &file_protos_external_proto_enumTypes[2]
+}
+
+func (x TrackerGetCalibrationResponse_CalibrationResult) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrackerGetCalibrationResponse_CalibrationResult.Descriptor instead.
+func (TrackerGetCalibrationResponse_CalibrationResult) EnumDescriptor() ([]byte, []int) {
+	return file_protos_external_proto_rawDescGZIP(), []int{18, 0}
+}
+
+type TrackerStartTrackingRequest_TrackingMode int32
+
+const (
+	// Track the LEDs in the tokens' bases
+	TrackerStartTrackingRequest_LED_MARKERS TrackerStartTrackingRequest_TrackingMode = 0
+	// Track ArUco tags (4x4, IDs 0 to 49) printed on the tokens' bases, which
+	// need no batteries and show which way the token faces
+	TrackerStartTrackingRequest_ARUCO_TAGS TrackerStartTrackingRequest_TrackingMode = 1
+)
+
+// Enum value maps for TrackerStartTrackingRequest_TrackingMode.
+var (
+	TrackerStartTrackingRequest_TrackingMode_name = map[int32]string{
+		0: "LED_MARKERS",
+		1: "ARUCO_TAGS",
+	}
+	TrackerStartTrackingRequest_TrackingMode_value = map[string]int32{
+		"LED_MARKERS": 0,
+		"ARUCO_TAGS":  1,
+	}
+)
+
+func (x TrackerStartTrackingRequest_TrackingMode) Enum() *TrackerStartTrackingRequest_TrackingMode {
+	p := new(TrackerStartTrackingRequest_TrackingMode)
+	*p = x
+	return p
+}
+
+func (x TrackerStartTrackingRequest_TrackingMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrackerStartTrackingRequest_TrackingMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_external_proto_enumTypes[3].Descriptor()
+}
+
+func (TrackerStartTrackingRequest_TrackingMode) Type() protoreflect.EnumType {
+	return &file_protos_external_proto_enumTypes[3]
+}
+
+func (x TrackerStartTrackingRequest_TrackingMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrackerStartTrackingRequest_TrackingMode.Descriptor instead.
+func (TrackerStartTrackingRequest_TrackingMode) EnumDescriptor() ([]byte, []int) {
+	return file_protos_external_proto_rawDescGZIP(), []int{19, 0}
+}
+
+type TrackerMarker_HeadingSource int32
+
+const (
+	TrackerMarker_NONE TrackerMarker_HeadingSource = 0
+	// The direction of the top edge of the token's ArUco tag
+	TrackerMarker_TAG TrackerMarker_HeadingSource = 1
+	// The direction from the back (red) LED of a two LED base to its front
+	// (green) LED, when tracking with ledPairHeadings. Both LEDs are reported,
+	// see pairedKey.
+	TrackerMarker_LED_PAIR TrackerMarker_HeadingSource = 2
+	// The long axis of an elongated blob, e.g. a light bar. Which end is the
+	// front can't be told, so it may be off by half a turn.
+	TrackerMarker_ELONGATION TrackerMarker_HeadingSource = 3
+)
+
+// Enum value maps for TrackerMarker_HeadingSource.
+var (
+	TrackerMarker_HeadingSource_name = map[int32]string{
+		0: "NONE",
+		1: "TAG",
+		2: "LED_PAIR",
+		3: "ELONGATION",
+	}
+	TrackerMarker_HeadingSource_value = map[string]int32{
+		"NONE":       0,
+		"TAG":        1,
+		"LED_PAIR":   2,
+		"ELONGATION": 3,
+	}
+)
+
+func (x TrackerMarker_HeadingSource) Enum() *TrackerMarker_HeadingSource {
+	p := new(TrackerMarker_HeadingSource)
+	*p = x
+	return p
+}
+
+func (x TrackerMarker_HeadingSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrackerMarker_HeadingSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_external_proto_enumTypes[4].Descriptor()
+}
+
+func (TrackerMarker_HeadingSource) Type() protoreflect.EnumType {
+	return &file_protos_external_proto_enumTypes[4]
+}
+
+func (x TrackerMarker_HeadingSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrackerMarker_HeadingSource.Descriptor instead.
+func (TrackerMarker_HeadingSource) EnumDescriptor() ([]byte, []int) {
+	return file_protos_external_proto_rawDescGZIP(), []int{27, 0}
+}
+
+type TrackerMarkerEvent_EventType int32
+
+const (
+	// A new marker has been seen for long enough to be trusted
+	TrackerMarkerEvent_APPEARED TrackerMarkerEvent_EventType = 0
+	// The marker has moved far enough since it appeared or last moved
+	TrackerMarkerEvent_MOVED TrackerMarkerEvent_EventType = 1
+	// The marker can't be seen, it keeps its key until it is recovered or
+	// removed
+	TrackerMarkerEvent_LOST      TrackerMarkerEvent_EventType = 2
+	TrackerMarkerEvent_RECOVERED TrackerMarkerEvent_EventType = 3
+	// The marker is gone, or its key has changed because its token ID was
+	// decoded, in which case it appears again under the new key
+	TrackerMarkerEvent_REMOVED TrackerMarkerEvent_EventType = 4
+)
+
+// Enum value maps for TrackerMarkerEvent_EventType.
+var (
+	TrackerMarkerEvent_EventType_name = map[int32]string{
+		0: "APPEARED",
+		1: "MOVED",
+		2: "LOST",
+		3: "RECOVERED",
+		4: "REMOVED",
+	}
+	TrackerMarkerEvent_EventType_value = map[string]int32{
+		"APPEARED":  0,
+		"MOVED":     1,
+		"LOST":      2,
+		"RECOVERED": 3,
+		"REMOVED":   4,
+	}
+)
+
+func (x TrackerMarkerEvent_EventType) Enum() *TrackerMarkerEvent_EventType {
+	p := new(TrackerMarkerEvent_EventType)
+	*p = x
+	return p
+}
+
+func (x TrackerMarkerEvent_EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrackerMarkerEvent_EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_external_proto_enumTypes[5].Descriptor()
+}
+
+func (TrackerMarkerEvent_EventType) Type() protoreflect.EnumType {
+	return &file_protos_external_proto_enumTypes[5]
+}
+
+func (x TrackerMarkerEvent_EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrackerMarkerEvent_EventType.Descriptor instead.
+func (TrackerMarkerEvent_EventType) EnumDescriptor() ([]byte, []int) {
+	return file_protos_external_proto_rawDescGZIP(), []int{29, 0}
+}
+
 type Packet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -177,6 +454,12 @@ type Request struct {
 	//	*Request_TrackerStartTrackingRequest
 	//	*Request_TrackerGetMarkerLocationRequest
 	//	*Request_TrackerUpdateMarkerLocationRequest
+	//	*Request_TrackerStartIntrinsicCalibrationRequest
+	//	*Request_TrackerGetIntrinsicCalibrationRequest
+	//	*Request_TrackerMarkerEventsRequest
+	//	*Request_TrackerResyncMarkersRequest
+	//	*Request_TrackerSetDisplayMaskRequest
+	//	*Request_TrackerSetDetectionParametersRequest
 	Message isRequest_Message `protobuf_oneof:"message"`
 }
 
@@ -303,6 +586,48 @@ func (x *Request) GetTrackerUpdateMarkerLocationRequest() *TrackerUpdateMarkerLo
 	return nil
 }
 
+func (x *Request) GetTrackerStartIntrinsicCalibrationRequest() *TrackerStartIntrinsicCalibrationRequest {
+	if x, ok := x.GetMessage().(*Request_TrackerStartIntrinsicCalibrationRequest); ok {
+		return x.TrackerStartIntrinsicCalibrationRequest
+	}
+	return nil
+}
+
+func (x *Request) GetTrackerGetIntrinsicCalibrationRequest() *TrackerGetIntrinsicCalibrationRequest {
+	if x, ok := x.GetMessage().(*Request_TrackerGetIntrinsicCalibrationRequest); ok {
+		return x.TrackerGetIntrinsicCalibrationRequest
+	}
+	return nil
+}
+
+func (x *Request) GetTrackerMarkerEventsRequest() *TrackerMarkerEventsRequest {
+	if x, ok := x.GetMessage().(*Request_TrackerMarkerEventsRequest); ok {
+		return x.TrackerMarkerEventsRequest
+	}
+	return nil
+}
+
+func (x *Request) GetTrackerResyncMarkersRequest() *TrackerResyncMarkersRequest {
+	if x, ok := x.GetMessage().(*Request_TrackerResyncMarkersRequest); ok {
+		return x.TrackerResyncMarkersRequest
+	}
+	return nil
+}
+
+func (x *Request) GetTrackerSetDisplayMaskRequest() *TrackerSetDisplayMaskRequest {
+	if x, ok := x.GetMessage().(*Request_TrackerSetDisplayMaskRequest); ok {
+		return x.TrackerSetDisplayMaskRequest
+	}
+	return nil
+}
+
+func (x *Request) GetTrackerSetDetectionParametersRequest() *TrackerSetDetectionParametersRequest {
+	if x, ok := x.GetMessage().(*Request_TrackerSetDetectionParametersRequest); ok {
+		return x.TrackerSetDetectionParametersRequest
+	}
+	return nil
+}
+
 type isRequest_Message interface {
 	isRequest_Message()
 }
@@ -367,6 +692,36 @@ type Request_TrackerUpdateMarkerLocationRequest struct {
 	TrackerUpdateMarkerLocationRequest *TrackerUpdateMarkerLocationRequest `protobuf:"bytes,16,opt,name=trackerUpdateMarkerLocationRequest,proto3,oneof"`
 }
 
+type Request_TrackerStartIntrinsicCalibrationRequest struct {
+	// Respond with AckResponse
+	TrackerStartIntrinsicCalibrationRequest *TrackerStartIntrinsicCalibrationRequest `protobuf:"bytes,17,opt,name=trackerStartIntrinsicCalibrationRequest,proto3,oneof"`
+}
+
+type Request_TrackerGetIntrinsicCalibrationRequest struct {
+	// Respond with TrackerGetIntrinsicCalibrationResponse
+	TrackerGetIntrinsicCalibrationRequest *TrackerGetIntrinsicCalibrationRequest `protobuf:"bytes,18,opt,name=trackerGetIntrinsicCalibrationRequest,proto3,oneof"`
+}
+
+type Request_TrackerMarkerEventsRequest struct {
+	// Don't respond
+	TrackerMarkerEventsRequest *TrackerMarkerEventsRequest `protobuf:"bytes,19,opt,name=trackerMarkerEventsRequest,proto3,oneof"`
+}
+
+type Request_TrackerResyncMarkersRequest struct {
+	// Respond with AckResponse
+	TrackerResyncMarkersRequest *TrackerResyncMarkersRequest `protobuf:"bytes,20,opt,name=trackerResyncMarkersRequest,proto3,oneof"`
+}
+
+type Request_TrackerSetDisplayMaskRequest struct {
+	// Respond with AckResponse
+	TrackerSetDisplayMaskRequest *TrackerSetDisplayMaskRequest `protobuf:"bytes,21,opt,name=trackerSetDisplayMaskRequest,proto3,oneof"`
+}
+
+type Request_TrackerSetDetectionParametersRequest struct {
+	// Respond with AckResponse
+	TrackerSetDetectionParametersRequest *TrackerSetDetectionParametersRequest `protobuf:"bytes,22,opt,name=trackerSetDetectionParametersRequest,proto3,oneof"`
+}
+
 func (*Request_HelloRequest) isRequest_Message() {}
 
 func (*Request_DisplaySceneRequest) isRequest_Message() {}
@@ -391,6 +746,18 @@ func (*Request_TrackerGetMarkerLocationRequest) isRequest_Message() {}
 
 func (*Request_TrackerUpdateMarkerLocationRequest) isRequest_Message() {}
 
+func (*Request_TrackerStartIntrinsicCalibrationRequest) isRequest_Message() {}
+
+func (*Request_TrackerGetIntrinsicCalibrationRequest) isRequest_Message() {}
+
+func (*Request_TrackerMarkerEventsRequest) isRequest_Message() {}
+
+func (*Request_TrackerResyncMarkersRequest) isRequest_Message() {}
+
+func (*Request_TrackerSetDisplayMaskRequest) isRequest_Message() {}
+
+func (*Request_TrackerSetDetectionParametersRequest) isRequest_Message() {}
+
 type Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -405,6 +772,7 @@ type Response struct {
 	//	*Response_TrackerGetStatusResponse
 	//	*Response_TrackerGetCalibrationResponse
 	//	*Response_TrackerGetMarkerLocationResponse
+	//	*Response_TrackerGetIntrinsicCalibrationResponse
 	Message isResponse_Message `protobuf_oneof:"message"`
 }
 
@@ -496,6 +864,13 @@ func (x *Response) GetTrackerGetMarkerLocationResponse() *TrackerGetMarkerLocati
 	return nil
 }
 
+func (x *Response) GetTrackerGetIntrinsicCalibrationResponse() *TrackerGetIntrinsicCalibrationResponse {
+	if x, ok := x.GetMessage().(*Response_TrackerGetIntrinsicCalibrationResponse); ok {
+		return x.TrackerGetIntrinsicCalibrationResponse
+	}
+	return nil
+}
+
 type isResponse_Message interface {
 	isResponse_Message()
 }
@@ -529,6 +904,10 @@ type Response_TrackerGetMarkerLocationResponse struct {
 	TrackerGetMarkerLocationResponse *TrackerGetMarkerLocationResponse `protobuf:"bytes,12,opt,name=trackerGetMarkerLocationResponse,proto3,oneof"`
 }
 
+type Response_TrackerGetIntrinsicCalibrationResponse struct {
+	TrackerGetIntrinsicCalibrationResponse *TrackerGetIntrinsicCalibrationResponse `protobuf:"bytes,13,opt,name=trackerGetIntrinsicCalibrationResponse,proto3,oneof"`
+}
+
 func (*Response_AckResponse) isResponse_Message() {}
 
 func (*Response_GetAssetResponse) isResponse_Message() {}
@@ -543,6 +922,8 @@ func (*Response_TrackerGetCalibrationResponse) isResponse_Message() {}
 
 func (*Response_TrackerGetMarkerLocationResponse) isResponse_Message() {}
 
+func (*Response_TrackerGetIntrinsicCalibrationResponse) isResponse_Message() {}
+
 type HelloRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1055,6 +1436,8 @@ type TrackerGetStatusResponse struct {
 	Uuid    string                                `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
 	Version string                                `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
 	State   TrackerGetStatusResponse_TrackerState `protobuf:"varint,5,opt,name=state,proto3,enum=TrackerGetStatusResponse_TrackerState" json:"state,omitempty"`
+	// Why the camera stopped delivering frames, empty while it is running
+	CameraError string `protobuf:"bytes,6,opt,name=cameraError,proto3" json:"cameraError,omitempty"`
 }
 
 func (x *TrackerGetStatusResponse) Reset() {
@@ -1110,6 +1493,13 @@ func (x *TrackerGetStatusResponse) GetState() TrackerGetStatusResponse_TrackerSt
 	return TrackerGetStatusResponse_IDLE
 }
 
+func (x *TrackerGetStatusResponse) GetCameraError() string {
+	if x != nil {
+		return x.CameraError
+	}
+	return ""
+}
+
 type TrackerSetIdleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1153,7 +1543,11 @@ type TrackerStartCalibrationRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// Positions on the table of the reference markers, at least 4 are required
 	Corners []*TrackerVector2D `protobuf:"bytes,1,rep,name=corners,proto3" json:"corners,omitempty"`
+	// ArUco ID of the marker displayed at each of the corners. When empty the
+	// markers are numbered 1, 2, 3... in order.
+	MarkerIds []int32 `protobuf:"varint,2,rep,packed,name=markerIds,proto3" json:"markerIds,omitempty"`
 }
 
 func (x *TrackerStartCalibrationRequest) Reset() {
@@ -1195,6 +1589,13 @@ func (x *TrackerStartCalibrationRequest) GetCorners() []*TrackerVector2D {
 	return nil
 }
 
+func (x *TrackerStartCalibrationRequest) GetMarkerIds() []int32 {
+	if x != nil {
+		return x.MarkerIds
+	}
+	return nil
+}
+
 type TrackerGetCalibrationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1238,8 +1639,34 @@ type TrackerGetCalibrationResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FoundCorners    []int32            `protobuf:"varint,1,rep,packed,name=foundCorners,proto3" json:"foundCorners,omitempty"`
+	FoundCorners []int32 `protobuf:"varint,1,rep,packed,name=foundCorners,proto3" json:"foundCorners,omitempty"`
+	// Pixel location of each of the foundCorners, in the same order
 	CornerLocations []*TrackerVector2D `protobuf:"bytes,2,rep,name=cornerLocations,proto3" json:"cornerLocations,omitempty"`
+	// RMS distance, in table units, between the real corners and the detected
+	// corners mapped through the homography of the last completed calibration.
+	// A homography fits any 4 corners exactly, so this is only meaningful, and
+	// can only fail a calibration, when more than 4 markers are used. With 4,
+	// only the cornerDeviations check applies.
+	ReprojectionError float64 `protobuf:"fixed64,3,opt,name=reprojectionError,proto3" json:"reprojectionError,omitempty"`
+	// Whether each marker of the last completed calibration, see
+	// calibratedMarkers, agreed with the homography. Markers that didn't were
+	// left out of it.
+	Inliers []bool `protobuf:"varint,4,rep,packed,name=inliers,proto3" json:"inliers,omitempty"`
+	// How much, in pixels, each of the foundCorners has moved over the last
+	// second. Calibration is rejected if a corner isn't steady.
+	CornerDeviations []float64                                       `protobuf:"fixed64,5,rep,packed,name=cornerDeviations,proto3" json:"cornerDeviations,omitempty"`
+	Result           TrackerGetCalibrationResponse_CalibrationResult `protobuf:"varint,6,opt,name=result,proto3,enum=TrackerGetCalibrationResponse_CalibrationResult" json:"result,omitempty"`
+	FailureReason    string                                          `protobuf:"bytes,7,opt,name=failureReason,proto3" json:"failureReason,omitempty"`
+	// IDs of the markers the last completed calibration was computed from.
+	// Markers that weren't steady are left out.
+	CalibratedMarkers []int32 `protobuf:"varint,8,rep,packed,name=calibratedMarkers,proto3" json:"calibratedMarkers,omitempty"`
+	// Distance, in table units, between each of calibratedMarkers and where its
+	// detected corner is mapped to by the homography, including the markers that
+	// weren't inliers
+	Residuals []float64 `protobuf:"fixed64,9,rep,packed,name=residuals,proto3" json:"residuals,omitempty"`
+	// The camera's intrinsics changed since the last completed calibration, so
+	// its homography was discarded and the table has to be calibrated again
+	NeedsRecalibration bool `protobuf:"varint,10,opt,name=needsRecalibration,proto3" json:"needsRecalibration,omitempty"`
 }
 
 func (x *TrackerGetCalibrationResponse) Reset() {
@@ -1288,12 +1715,91 @@ func (x *TrackerGetCalibrationResponse) GetCornerLocations() []*TrackerVector2D
 	return nil
 }
 
+func (x *TrackerGetCalibrationResponse) GetReprojectionError() float64 {
+	if x != nil {
+		return x.ReprojectionError
+	}
+	return 0
+}
+
+func (x *TrackerGetCalibrationResponse) GetInliers() []bool {
+	if x != nil {
+		return x.Inliers
+	}
+	return nil
+}
+
+func (x *TrackerGetCalibrationResponse) GetCornerDeviations() []float64 {
+	if x != nil {
+		return x.CornerDeviations
+	}
+	return nil
+}
+
+func (x *TrackerGetCalibrationResponse) GetResult() TrackerGetCalibrationResponse_CalibrationResult {
+	if x != nil {
+		return x.Result
+	}
+	return TrackerGetCalibrationResponse_NONE
+}
+
+func (x *TrackerGetCalibrationResponse) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *TrackerGetCalibrationResponse) GetCalibratedMarkers() []int32 {
+	if x != nil {
+		return x.CalibratedMarkers
+	}
+	return nil
+}
+
+func (x *TrackerGetCalibrationResponse) GetResiduals() []float64 {
+	if x != nil {
+		return x.Residuals
+	}
+	return nil
+}
+
+func (x *TrackerGetCalibrationResponse) GetNeedsRecalibration() bool {
+	if x != nil {
+		return x.NeedsRecalibration
+	}
+	return false
+}
+
 type TrackerStartTrackingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	UpdateRateMs float32 `protobuf:"fixed32,1,opt,name=updateRateMs,proto3" json:"updateRateMs,omitempty"`
+	// Height of the markers' LEDs above the table, in table units
+	MarkerHeight float32 `protobuf:"fixed32,2,opt,name=markerHeight,proto3" json:"markerHeight,omitempty"`
+	// How long a marker that can't be seen, e.g. because a hand is over it,
+	// keeps its identity and last location before it is removed. Defaults to 5
+	// seconds.
+	LostGracePeriodMs float32 `protobuf:"fixed32,3,opt,name=lostGracePeriodMs,proto3" json:"lostGracePeriodMs,omitempty"`
+	// Only send the markers that changed in each
+	// TrackerUpdateMarkerLocationRequest, see keyframe
+	DeltaUpdates bool `protobuf:"varint,4,opt,name=deltaUpdates,proto3" json:"deltaUpdates,omitempty"`
+	// How far, in table units, a marker has to move to be sent in a delta
+	// update. Defaults to 0.1.
+	DeltaTolerance float32 `protobuf:"fixed32,5,opt,name=deltaTolerance,proto3" json:"deltaTolerance,omitempty"`
+	// Send every marker once every this many updates. Defaults to 20.
+	KeyframeInterval int32                                    `protobuf:"varint,6,opt,name=keyframeInterval,proto3" json:"keyframeInterval,omitempty"`
+	Mode             TrackerStartTrackingRequest_TrackingMode `protobuf:"varint,7,opt,name=mode,proto3,enum=TrackerStartTrackingRequest_TrackingMode" json:"mode,omitempty"`
+	// How far, in table units, outside the calibrated table markers are still
+	// tracked. Anything further out, e.g. a lamp or phone beside the table, is
+	// ignored. Defaults to 0.5.
+	TableMargin float32 `protobuf:"fixed32,8,opt,name=tableMargin,proto3" json:"tableMargin,omitempty"`
+	// Pair green LEDs with a nearby red LED and give them the heading from the
+	// red LED to the green one, for tokens with two LED bases. Off by default, as
+	// single LED tokens are red and green too.
+	LedPairHeadings bool `protobuf:"varint,9,opt,name=ledPairHeadings,proto3" json:"ledPairHeadings,omitempty"`
 }
 
 func (x *TrackerStartTrackingRequest) Reset() {
@@ -1335,30 +1841,714 @@ func (x *TrackerStartTrackingRequest) GetUpdateRateMs() float32 {
 	return 0
 }
 
-type TrackerGetMarkerLocationRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *TrackerStartTrackingRequest) GetMarkerHeight() float32 {
+	if x != nil {
+		return x.MarkerHeight
+	}
+	return 0
 }
 
-func (x *TrackerGetMarkerLocationRequest) Reset() {
-	*x = TrackerGetMarkerLocationRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_external_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *TrackerStartTrackingRequest) GetLostGracePeriodMs() float32 {
+	if x != nil {
+		return x.LostGracePeriodMs
 	}
+	return 0
 }
 
-func (x *TrackerGetMarkerLocationRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TrackerStartTrackingRequest) GetDeltaUpdates() bool {
+	if x != nil {
+		return x.DeltaUpdates
+	}
+	return false
 }
 
-func (*TrackerGetMarkerLocationRequest) ProtoMessage() {}
+func (x *TrackerStartTrackingRequest) GetDeltaTolerance() float32 {
+	if x != nil {
+		return x.DeltaTolerance
+	}
+	return 0
+}
 
-func (x *TrackerGetMarkerLocationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_external_proto_msgTypes[20]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *TrackerStartTrackingRequest) GetKeyframeInterval() int32 {
+	if x != nil {
+		return x.KeyframeInterval
+	}
+	return 0
+}
+
+func (x *TrackerStartTrackingRequest) GetMode() TrackerStartTrackingRequest_TrackingMode {
+	if x != nil {
+		return x.Mode
+	}
+	return TrackerStartTrackingRequest_LED_MARKERS
+}
+
+func (x *TrackerStartTrackingRequest) GetTableMargin() float32 {
+	if x != nil {
+		return x.TableMargin
+	}
+	return 0
+}
+
+func (x *TrackerStartTrackingRequest) GetLedPairHeadings() bool {
+	if x != nil {
+		return x.LedPairHeadings
+	}
+	return false
+}
+
+type TrackerGetMarkerLocationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TrackerGetMarkerLocationRequest) Reset() {
+	*x = TrackerGetMarkerLocationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_external_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackerGetMarkerLocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackerGetMarkerLocationRequest) ProtoMessage() {}
+
+func (x *TrackerGetMarkerLocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_external_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackerGetMarkerLocationRequest.ProtoReflect.Descriptor instead.
+func (*TrackerGetMarkerLocationRequest) Descriptor() ([]byte, []int) {
+	return file_protos_external_proto_rawDescGZIP(), []int{20}
+}
+
+type TrackerGetMarkerLocationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Keyed the same way as TrackerUpdateMarkerLocationRequest
+	MarkerLocations map[int32]*TrackerVector2D   `protobuf:"bytes,1,rep,name=markerLocations,proto3" json:"markerLocations,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MarkerColors    map[int32]TrackerMarkerColor `protobuf:"bytes,2,rep,name=markerColors,proto3" json:"markerColors,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=TrackerMarkerColor"`
+	Markers         map[int32]*TrackerMarker     `protobuf:"bytes,3,rep,name=markers,proto3" json:"markers,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *TrackerGetMarkerLocationResponse) Reset() {
+	*x = TrackerGetMarkerLocationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_external_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackerGetMarkerLocationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackerGetMarkerLocationResponse) ProtoMessage() {}
+
+func (x *TrackerGetMarkerLocationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_external_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackerGetMarkerLocationResponse.ProtoReflect.Descriptor instead.
+func (*TrackerGetMarkerLocationResponse) Descriptor() ([]byte, []int) {
+	return file_protos_external_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TrackerGetMarkerLocationResponse) GetMarkerLocations() map[int32]*TrackerVector2D {
+	if x != nil {
+		return x.MarkerLocations
+	}
+	return nil
+}
+
+func (x *TrackerGetMarkerLocationResponse) GetMarkerColors() map[int32]TrackerMarkerColor {
+	if x != nil {
+		return x.MarkerColors
+	}
+	return nil
+}
+
+func (x *TrackerGetMarkerLocationResponse) GetMarkers() map[int32]*TrackerMarker {
+	if x != nil {
+		return x.Markers
+	}
+	return nil
+}
+
+type TrackerUpdateMarkerLocationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Keys from 256 up are the blink coded ID of the marker's token, or the ID of
+	// its ArUco tag, plus 256, and stay the same whenever that token is seen.
+	// Other markers are numbered in the order they were found.
+	MarkerLocations map[int32]*TrackerVector2D `protobuf:"bytes,1,rep,name=markerLocations,proto3" json:"markerLocations,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Color of each marker's LED, with the same keys as markerLocations
+	MarkerColors map[int32]TrackerMarkerColor `protobuf:"bytes,2,rep,name=markerColors,proto3" json:"markerColors,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=TrackerMarkerColor"`
+	// Everything known about each marker, with the same keys as markerLocations
+	Markers map[int32]*TrackerMarker `protobuf:"bytes,3,rep,name=markers,proto3" json:"markers,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Increases by one with every update, so a client can tell when it missed
+	// one and send a TrackerResyncMarkersRequest
+	Sequence uint32 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Keyframes include every marker. Otherwise, in delta mode, only markers that
+	// changed since they were last sent are included, and removedKeys lists the
+	// markers that are gone. Updates are skipped when nothing changed.
+	Keyframe    bool    `protobuf:"varint,5,opt,name=keyframe,proto3" json:"keyframe,omitempty"`
+	RemovedKeys []int32 `protobuf:"varint,6,rep,packed,name=removedKeys,proto3" json:"removedKeys,omitempty"`
+}
+
+func (x *TrackerUpdateMarkerLocationRequest) Reset() {
+	*x = TrackerUpdateMarkerLocationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_external_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackerUpdateMarkerLocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackerUpdateMarkerLocationRequest) ProtoMessage() {}
+
+func (x *TrackerUpdateMarkerLocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_external_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackerUpdateMarkerLocationRequest.ProtoReflect.Descriptor instead.
+func (*TrackerUpdateMarkerLocationRequest) Descriptor() ([]byte, []int) {
+	return file_protos_external_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TrackerUpdateMarkerLocationRequest) GetMarkerLocations() map[int32]*TrackerVector2D {
+	if x != nil {
+		return x.MarkerLocations
+	}
+	return nil
+}
+
+func (x *TrackerUpdateMarkerLocationRequest) GetMarkerColors() map[int32]TrackerMarkerColor {
+	if x != nil {
+		return x.MarkerColors
+	}
+	return nil
+}
+
+func (x *TrackerUpdateMarkerLocationRequest) GetMarkers() map[int32]*TrackerMarker {
+	if x != nil {
+		return x.Markers
+	}
+	return nil
+}
+
+func (x *TrackerUpdateMarkerLocationRequest) GetSequence() uint32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *TrackerUpdateMarkerLocationRequest) GetKeyframe() bool {
+	if x != nil {
+		return x.Keyframe
+	}
+	return false
+}
+
+func (x *TrackerUpdateMarkerLocationRequest) GetRemovedKeys() []int32 {
+	if x != nil {
+		return x.RemovedKeys
+	}
+	return nil
+}
+
+// Asks for the next TrackerUpdateMarkerLocationRequest to be a keyframe
+type TrackerResyncMarkersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TrackerResyncMarkersRequest) Reset() {
+	*x = TrackerResyncMarkersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_external_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackerResyncMarkersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackerResyncMarkersRequest) ProtoMessage() {}
+
+func (x *TrackerResyncMarkersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_external_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackerResyncMarkersRequest.ProtoReflect.Descriptor instead.
+func (*TrackerResyncMarkersRequest) Descriptor() ([]byte, []int) {
+	return file_protos_external_proto_rawDescGZIP(), []int{23}
+}
+
+// Tells the tracker what the table is displaying, so that bright parts of the
+// scene, e.g. lava or fire, aren't tracked as markers. Send it whenever the
+// displayed scene changes.
+type TrackerSetDisplayMaskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PNG or JPEG of what the table displays, covering the table from
+	// displayOrigin to displayOrigin + displaySize in table units. New markers
+	// aren't tracked where it shows something that looks like an LED of their
+	// color. Leave empty to stop masking displayed content.
+	DisplayImage  []byte           `protobuf:"bytes,1,opt,name=displayImage,proto3" json:"displayImage,omitempty"`
+	DisplayOrigin *TrackerVector2D `protobuf:"bytes,2,opt,name=displayOrigin,proto3" json:"displayOrigin,omitempty"`
+	DisplaySize   *TrackerVector2D `protobuf:"bytes,3,opt,name=displaySize,proto3" json:"displaySize,omitempty"`
+	// Areas of the table where markers are never tracked
+	ExclusionPolygons []*TrackerPolygon `protobuf:"bytes,4,rep,name=exclusionPolygons,proto3" json:"exclusionPolygons,omitempty"`
+}
+
+func (x *TrackerSetDisplayMaskRequest) Reset() {
+	*x = TrackerSetDisplayMaskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_external_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackerSetDisplayMaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackerSetDisplayMaskRequest) ProtoMessage() {}
+
+func (x *TrackerSetDisplayMaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_external_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackerSetDisplayMaskRequest.ProtoReflect.Descriptor instead.
+func (*TrackerSetDisplayMaskRequest) Descriptor() ([]byte, []int) {
+	return file_protos_external_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TrackerSetDisplayMaskRequest) GetDisplayImage() []byte {
+	if x != nil {
+		return x.DisplayImage
+	}
+	return nil
+}
+
+func (x *TrackerSetDisplayMaskRequest) GetDisplayOrigin() *TrackerVector2D {
+	if x != nil {
+		return x.DisplayOrigin
+	}
+	return nil
+}
+
+func (x *TrackerSetDisplayMaskRequest) GetDisplaySize() *TrackerVector2D {
+	if x != nil {
+		return x.DisplaySize
+	}
+	return nil
+}
+
+func (x *TrackerSetDisplayMaskRequest) GetExclusionPolygons() []*TrackerPolygon {
+	if x != nil {
+		return x.ExclusionPolygons
+	}
+	return nil
+}
+
+// Tunes how LEDs are picked out of the camera's frames, e.g. for the room's
+// lighting. It takes effect straight away, even while tracking. Fields left at
+// zero are set to their defaults.
+type TrackerSetDetectionParametersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Lowest brightness, from 0 to 255, of an LED's pixels. Defaults to 120.
+	MinBrightness float32 `protobuf:"fixed32,1,opt,name=minBrightness,proto3" json:"minBrightness,omitempty"`
+	// Pixels have to be adaptiveOffset brighter than the mean of the
+	// adaptiveBlockSize pixels square around them. Default to 40 and 41.
+	AdaptiveOffset    float32 `protobuf:"fixed32,2,opt,name=adaptiveOffset,proto3" json:"adaptiveOffset,omitempty"`
+	AdaptiveBlockSize int32   `protobuf:"varint,3,opt,name=adaptiveBlockSize,proto3" json:"adaptiveBlockSize,omitempty"`
+	// The background model learns what the table looks like without markers,
+	// taking in backgroundLearningRate of each frame, from 0 to 1, and pixels
+	// have to be foregroundThreshold brighter than it. Default to 0.02 and 40.
+	BackgroundLearningRate float32 `protobuf:"fixed32,4,opt,name=backgroundLearningRate,proto3" json:"backgroundLearningRate,omitempty"`
+	ForegroundThreshold    float32 `protobuf:"fixed32,5,opt,name=foregroundThreshold,proto3" json:"foregroundThreshold,omitempty"`
+	DisableBackgroundModel bool    `protobuf:"varint,6,opt,name=disableBackgroundModel,proto3" json:"disableBackgroundModel,omitempty"`
+	// Forget the learned background, e.g. after the camera has been moved
+	ResetBackground bool `protobuf:"varint,7,opt,name=resetBackground,proto3" json:"resetBackground,omitempty"`
+}
+
+func (x *TrackerSetDetectionParametersRequest) Reset() {
+	*x = TrackerSetDetectionParametersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_external_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackerSetDetectionParametersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackerSetDetectionParametersRequest) ProtoMessage() {}
+
+func (x *TrackerSetDetectionParametersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_external_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackerSetDetectionParametersRequest.ProtoReflect.Descriptor instead.
+func (*TrackerSetDetectionParametersRequest) Descriptor() ([]byte, []int) {
+	return file_protos_external_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TrackerSetDetectionParametersRequest) GetMinBrightness() float32 {
+	if x != nil {
+		return x.MinBrightness
+	}
+	return 0
+}
+
+func (x *TrackerSetDetectionParametersRequest) GetAdaptiveOffset() float32 {
+	if x != nil {
+		return x.AdaptiveOffset
+	}
+	return 0
+}
+
+func (x *TrackerSetDetectionParametersRequest) GetAdaptiveBlockSize() int32 {
+	if x != nil {
+		return x.AdaptiveBlockSize
+	}
+	return 0
+}
+
+func (x *TrackerSetDetectionParametersRequest) GetBackgroundLearningRate() float32 {
+	if x != nil {
+		return x.BackgroundLearningRate
+	}
+	return 0
+}
+
+func (x *TrackerSetDetectionParametersRequest) GetForegroundThreshold() float32 {
+	if x != nil {
+		return x.ForegroundThreshold
+	}
+	return 0
+}
+
+func (x *TrackerSetDetectionParametersRequest) GetDisableBackgroundModel() bool {
+	if x != nil {
+		return x.DisableBackgroundModel
+	}
+	return false
+}
+
+func (x *TrackerSetDetectionParametersRequest) GetResetBackground() bool {
+	if x != nil {
+		return x.ResetBackground
+	}
+	return false
+}
+
+// Polygon on the table, in table units
+type TrackerPolygon struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vertices []*TrackerVector2D `protobuf:"bytes,1,rep,name=vertices,proto3" json:"vertices,omitempty"`
+}
+
+func (x *TrackerPolygon) Reset() {
+	*x = TrackerPolygon{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_external_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackerPolygon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackerPolygon) ProtoMessage() {}
+
+func (x *TrackerPolygon) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_external_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackerPolygon.ProtoReflect.Descriptor instead.
+func (*TrackerPolygon) Descriptor() ([]byte, []int) {
+	return file_protos_external_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TrackerPolygon) GetVertices() []*TrackerVector2D {
+	if x != nil {
+		return x.Vertices
+	}
+	return nil
+}
+
+type TrackerMarker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Position on the table, and how far it moves across the table per second
+	Location *TrackerVector2D `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Velocity *TrackerVector2D `protobuf:"bytes,2,opt,name=velocity,proto3" json:"velocity,omitempty"`
+	// Sub-pixel position of the marker's LED in the camera's image
+	Pixel *TrackerVector2D   `protobuf:"bytes,3,opt,name=pixel,proto3" json:"pixel,omitempty"`
+	Color TrackerMarkerColor `protobuf:"varint,4,opt,name=color,proto3,enum=TrackerMarkerColor" json:"color,omitempty"`
+	// Area of the LED's blob in pixels, how close it is to a circle from 0 to 1
+	// and its mean brightness from 0 to 255
+	Area        float32 `protobuf:"fixed32,5,opt,name=area,proto3" json:"area,omitempty"`
+	Circularity float32 `protobuf:"fixed32,6,opt,name=circularity,proto3" json:"circularity,omitempty"`
+	Brightness  float32 `protobuf:"fixed32,7,opt,name=brightness,proto3" json:"brightness,omitempty"`
+	// The marker can't currently be seen, and location is where it was last
+	// seen
+	Lost bool `protobuf:"varint,8,opt,name=lost,proto3" json:"lost,omitempty"`
+	// Which way the token faces on the table, in radians from the table's x
+	// axis towards its y axis. Only set if hasHeading is.
+	Heading    float32 `protobuf:"fixed32,9,opt,name=heading,proto3" json:"heading,omitempty"`
+	HasHeading bool    `protobuf:"varint,10,opt,name=hasHeading,proto3" json:"hasHeading,omitempty"`
+	// How sure the tracker is of the heading, from 0 to 1. It drops while the
+	// heading can't be measured, until the heading is dropped.
+	HeadingConfidence float32                     `protobuf:"fixed32,11,opt,name=headingConfidence,proto3" json:"headingConfidence,omitempty"`
+	HeadingSource     TrackerMarker_HeadingSource `protobuf:"varint,12,opt,name=headingSource,proto3,enum=TrackerMarker_HeadingSource" json:"headingSource,omitempty"`
+	// Key of the other LED of a two LED base, or 0
+	PairedKey int32 `protobuf:"varint,13,opt,name=pairedKey,proto3" json:"pairedKey,omitempty"`
+}
+
+func (x *TrackerMarker) Reset() {
+	*x = TrackerMarker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_external_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackerMarker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackerMarker) ProtoMessage() {}
+
+func (x *TrackerMarker) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_external_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackerMarker.ProtoReflect.Descriptor instead.
+func (*TrackerMarker) Descriptor() ([]byte, []int) {
+	return file_protos_external_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TrackerMarker) GetLocation() *TrackerVector2D {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *TrackerMarker) GetVelocity() *TrackerVector2D {
+	if x != nil {
+		return x.Velocity
+	}
+	return nil
+}
+
+func (x *TrackerMarker) GetPixel() *TrackerVector2D {
+	if x != nil {
+		return x.Pixel
+	}
+	return nil
+}
+
+func (x *TrackerMarker) GetColor() TrackerMarkerColor {
+	if x != nil {
+		return x.Color
+	}
+	return TrackerMarkerColor_COLOR_UNKNOWN
+}
+
+func (x *TrackerMarker) GetArea() float32 {
+	if x != nil {
+		return x.Area
+	}
+	return 0
+}
+
+func (x *TrackerMarker) GetCircularity() float32 {
+	if x != nil {
+		return x.Circularity
+	}
+	return 0
+}
+
+func (x *TrackerMarker) GetBrightness() float32 {
+	if x != nil {
+		return x.Brightness
+	}
+	return 0
+}
+
+func (x *TrackerMarker) GetLost() bool {
+	if x != nil {
+		return x.Lost
+	}
+	return false
+}
+
+func (x *TrackerMarker) GetHeading() float32 {
+	if x != nil {
+		return x.Heading
+	}
+	return 0
+}
+
+func (x *TrackerMarker) GetHasHeading() bool {
+	if x != nil {
+		return x.HasHeading
+	}
+	return false
+}
+
+func (x *TrackerMarker) GetHeadingConfidence() float32 {
+	if x != nil {
+		return x.HeadingConfidence
+	}
+	return 0
+}
+
+func (x *TrackerMarker) GetHeadingSource() TrackerMarker_HeadingSource {
+	if x != nil {
+		return x.HeadingSource
+	}
+	return TrackerMarker_NONE
+}
+
+func (x *TrackerMarker) GetPairedKey() int32 {
+	if x != nil {
+		return x.PairedKey
+	}
+	return 0
+}
+
+// Sent while tracking whenever markers change, alongside the periodic
+// TrackerUpdateMarkerLocationRequest snapshots
+type TrackerMarkerEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*TrackerMarkerEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *TrackerMarkerEventsRequest) Reset() {
+	*x = TrackerMarkerEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_external_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackerMarkerEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackerMarkerEventsRequest) ProtoMessage() {}
+
+func (x *TrackerMarkerEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_external_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -1368,36 +2558,46 @@ func (x *TrackerGetMarkerLocationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrackerGetMarkerLocationRequest.ProtoReflect.Descriptor instead.
-func (*TrackerGetMarkerLocationRequest) Descriptor() ([]byte, []int) {
-	return file_protos_external_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use TrackerMarkerEventsRequest.ProtoReflect.Descriptor instead.
+func (*TrackerMarkerEventsRequest) Descriptor() ([]byte, []int) {
+	return file_protos_external_proto_rawDescGZIP(), []int{28}
 }
 
-type TrackerGetMarkerLocationResponse struct {
+func (x *TrackerMarkerEventsRequest) GetEvents() []*TrackerMarkerEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type TrackerMarkerEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MarkerLocations map[int32]*TrackerVector2D `protobuf:"bytes,1,rep,name=markerLocations,proto3" json:"markerLocations,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Type TrackerMarkerEvent_EventType `protobuf:"varint,1,opt,name=type,proto3,enum=TrackerMarkerEvent_EventType" json:"type,omitempty"`
+	// Keyed the same way as TrackerUpdateMarkerLocationRequest
+	Key    int32          `protobuf:"varint,2,opt,name=key,proto3" json:"key,omitempty"`
+	Marker *TrackerMarker `protobuf:"bytes,3,opt,name=marker,proto3" json:"marker,omitempty"`
 }
 
-func (x *TrackerGetMarkerLocationResponse) Reset() {
-	*x = TrackerGetMarkerLocationResponse{}
+func (x *TrackerMarkerEvent) Reset() {
+	*x = TrackerMarkerEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_external_proto_msgTypes[21]
+		mi := &file_protos_external_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TrackerGetMarkerLocationResponse) String() string {
+func (x *TrackerMarkerEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrackerGetMarkerLocationResponse) ProtoMessage() {}
+func (*TrackerMarkerEvent) ProtoMessage() {}
 
-func (x *TrackerGetMarkerLocationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_external_proto_msgTypes[21]
+func (x *TrackerMarkerEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_external_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1408,43 +2608,62 @@ func (x *TrackerGetMarkerLocationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrackerGetMarkerLocationResponse.ProtoReflect.Descriptor instead.
-func (*TrackerGetMarkerLocationResponse) Descriptor() ([]byte, []int) {
-	return file_protos_external_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use TrackerMarkerEvent.ProtoReflect.Descriptor instead.
+func (*TrackerMarkerEvent) Descriptor() ([]byte, []int) {
+	return file_protos_external_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *TrackerGetMarkerLocationResponse) GetMarkerLocations() map[int32]*TrackerVector2D {
+func (x *TrackerMarkerEvent) GetType() TrackerMarkerEvent_EventType {
 	if x != nil {
-		return x.MarkerLocations
+		return x.Type
+	}
+	return TrackerMarkerEvent_APPEARED
+}
+
+func (x *TrackerMarkerEvent) GetKey() int32 {
+	if x != nil {
+		return x.Key
+	}
+	return 0
+}
+
+func (x *TrackerMarkerEvent) GetMarker() *TrackerMarker {
+	if x != nil {
+		return x.Marker
 	}
 	return nil
 }
 
-type TrackerUpdateMarkerLocationRequest struct {
+// Captures views of a checkerboard until TrackerSetIdleRequest is sent, then
+// calibrates the camera's lens from them
+type TrackerStartIntrinsicCalibrationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MarkerLocations map[int32]*TrackerVector2D `protobuf:"bytes,1,rep,name=markerLocations,proto3" json:"markerLocations,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Number of inner corners along each side of the checkerboard
+	Columns    int32   `protobuf:"varint,1,opt,name=columns,proto3" json:"columns,omitempty"`
+	Rows       int32   `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`
+	SquareSize float32 `protobuf:"fixed32,3,opt,name=squareSize,proto3" json:"squareSize,omitempty"`
 }
 
-func (x *TrackerUpdateMarkerLocationRequest) Reset() {
-	*x = TrackerUpdateMarkerLocationRequest{}
+func (x *TrackerStartIntrinsicCalibrationRequest) Reset() {
+	*x = TrackerStartIntrinsicCalibrationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_external_proto_msgTypes[22]
+		mi := &file_protos_external_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TrackerUpdateMarkerLocationRequest) String() string {
+func (x *TrackerStartIntrinsicCalibrationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrackerUpdateMarkerLocationRequest) ProtoMessage() {}
+func (*TrackerStartIntrinsicCalibrationRequest) ProtoMessage() {}
 
-func (x *TrackerUpdateMarkerLocationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_external_proto_msgTypes[22]
+func (x *TrackerStartIntrinsicCalibrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_external_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1455,16 +2674,139 @@ func (x *TrackerUpdateMarkerLocationRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrackerUpdateMarkerLocationRequest.ProtoReflect.Descriptor instead.
-func (*TrackerUpdateMarkerLocationRequest) Descriptor() ([]byte, []int) {
-	return file_protos_external_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use TrackerStartIntrinsicCalibrationRequest.ProtoReflect.Descriptor instead.
+func (*TrackerStartIntrinsicCalibrationRequest) Descriptor() ([]byte, []int) {
+	return file_protos_external_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *TrackerUpdateMarkerLocationRequest) GetMarkerLocations() map[int32]*TrackerVector2D {
+func (x *TrackerStartIntrinsicCalibrationRequest) GetColumns() int32 {
 	if x != nil {
-		return x.MarkerLocations
+		return x.Columns
 	}
-	return nil
+	return 0
+}
+
+func (x *TrackerStartIntrinsicCalibrationRequest) GetRows() int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *TrackerStartIntrinsicCalibrationRequest) GetSquareSize() float32 {
+	if x != nil {
+		return x.SquareSize
+	}
+	return 0
+}
+
+type TrackerGetIntrinsicCalibrationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TrackerGetIntrinsicCalibrationRequest) Reset() {
+	*x = TrackerGetIntrinsicCalibrationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_external_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackerGetIntrinsicCalibrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackerGetIntrinsicCalibrationRequest) ProtoMessage() {}
+
+func (x *TrackerGetIntrinsicCalibrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_external_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackerGetIntrinsicCalibrationRequest.ProtoReflect.Descriptor instead.
+func (*TrackerGetIntrinsicCalibrationRequest) Descriptor() ([]byte, []int) {
+	return file_protos_external_proto_rawDescGZIP(), []int{31}
+}
+
+type TrackerGetIntrinsicCalibrationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Calibrated        bool    `protobuf:"varint,1,opt,name=calibrated,proto3" json:"calibrated,omitempty"`
+	ReprojectionError float64 `protobuf:"fixed64,2,opt,name=reprojectionError,proto3" json:"reprojectionError,omitempty"`
+	ViewsCaptured     int32   `protobuf:"varint,3,opt,name=viewsCaptured,proto3" json:"viewsCaptured,omitempty"`
+	ViewsRequired     int32   `protobuf:"varint,4,opt,name=viewsRequired,proto3" json:"viewsRequired,omitempty"`
+}
+
+func (x *TrackerGetIntrinsicCalibrationResponse) Reset() {
+	*x = TrackerGetIntrinsicCalibrationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_external_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackerGetIntrinsicCalibrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackerGetIntrinsicCalibrationResponse) ProtoMessage() {}
+
+func (x *TrackerGetIntrinsicCalibrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_external_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackerGetIntrinsicCalibrationResponse.ProtoReflect.Descriptor instead.
+func (*TrackerGetIntrinsicCalibrationResponse) Descriptor() ([]byte, []int) {
+	return file_protos_external_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *TrackerGetIntrinsicCalibrationResponse) GetCalibrated() bool {
+	if x != nil {
+		return x.Calibrated
+	}
+	return false
+}
+
+func (x *TrackerGetIntrinsicCalibrationResponse) GetReprojectionError() float64 {
+	if x != nil {
+		return x.ReprojectionError
+	}
+	return 0
+}
+
+func (x *TrackerGetIntrinsicCalibrationResponse) GetViewsCaptured() int32 {
+	if x != nil {
+		return x.ViewsCaptured
+	}
+	return 0
+}
+
+func (x *TrackerGetIntrinsicCalibrationResponse) GetViewsRequired() int32 {
+	if x != nil {
+		return x.ViewsRequired
+	}
+	return 0
 }
 
 type GetTableConfigurationResponse_Resolution struct {
@@ -1479,7 +2821,7 @@ type GetTableConfigurationResponse_Resolution struct {
 func (x *GetTableConfigurationResponse_Resolution) Reset() {
 	*x = GetTableConfigurationResponse_Resolution{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_external_proto_msgTypes[23]
+		mi := &file_protos_external_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1492,7 +2834,7 @@ func (x *GetTableConfigurationResponse_Resolution) String() string {
 func (*GetTableConfigurationResponse_Resolution) ProtoMessage() {}
 
 func (x *GetTableConfigurationResponse_Resolution) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_external_proto_msgTypes[23]
+	mi := &file_protos_external_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1535,8 +2877,8 @@ var file_protos_external_proto_rawDesc = []byte{
 	0x00, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x08, 0x72, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xc6,
-	0x08, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x0c, 0x68, 0x65,
+	0x6e, 0x73, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xf0,
+	0x0d, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x0c, 0x68, 0x65,
 	0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x0d, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
 	0x00, 0x52, 0x0c, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
@@ -1603,151 +2945,429 @@ var file_protos_external_proto_rawDesc = []byte{
 	0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x22, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65,
 	0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x4c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x09, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xf8, 0x04, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x0b, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x41, 0x63, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x63, 0x6b, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x10, 0x67, 0x65, 0x74, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x10, 0x67, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x1d, 0x67, 0x65, 0x74, 0x54, 0x61,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x84, 0x01, 0x0a,
+	0x27, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x49, 0x6e, 0x74,
+	0x72, 0x69, 0x6e, 0x73, 0x69, 0x63, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x49, 0x6e, 0x74,
+	0x72, 0x69, 0x6e, 0x73, 0x69, 0x63, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x27, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x49, 0x6e, 0x74, 0x72, 0x69, 0x6e, 0x73, 0x69,
+	0x63, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x7e, 0x0a, 0x25, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x47, 0x65,
+	0x74, 0x49, 0x6e, 0x74, 0x72, 0x69, 0x6e, 0x73, 0x69, 0x63, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x47, 0x65, 0x74, 0x49,
+	0x6e, 0x74, 0x72, 0x69, 0x6e, 0x73, 0x69, 0x63, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x25, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x72, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x72, 0x69, 0x6e, 0x73, 0x69,
+	0x63, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x5d, 0x0a, 0x1a, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x72, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x1a, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x60, 0x0a, 0x1b, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x79, 0x6e, 0x63, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x1b, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x63, 0x0a, 0x1c, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x53,
+	0x65, 0x74, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x72, 0x53, 0x65, 0x74, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4d, 0x61,
+	0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x1c, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x72, 0x53, 0x65, 0x74, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4d, 0x61,
+	0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7b, 0x0a, 0x24, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x72, 0x53, 0x65, 0x74, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x72, 0x53, 0x65, 0x74, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x24, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x65, 0x74, 0x44, 0x65, 0x74, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0xfc, 0x05, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30,
+	0x0a, 0x0b, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3f, 0x0a, 0x10, 0x67, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
+	0x10, 0x67, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x66, 0x0a, 0x1d, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61,
 	0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
-	0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00,
-	0x52, 0x1d, 0x67, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x54, 0x0a, 0x17, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x65,
-	0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x65,
-	0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x17, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x1d, 0x67, 0x65, 0x74, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x17, 0x67, 0x65, 0x74,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x17, 0x67, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x57, 0x0a, 0x18, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x18,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x1d, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x72, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x69,
+	0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
+	0x00, 0x52, 0x1d, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c,
+	0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x6f, 0x0a, 0x20, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x47, 0x65, 0x74, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x72, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
+	0x20, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x81, 0x01, 0x0a, 0x26, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x74, 0x72, 0x69, 0x6e, 0x73, 0x69, 0x63, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x47, 0x65, 0x74, 0x49,
+	0x6e, 0x74, 0x72, 0x69, 0x6e, 0x73, 0x69, 0x63, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x26, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x72, 0x69, 0x6e, 0x73,
+	0x69, 0x63, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x0e, 0x0a, 0x0c, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x0d, 0x0a, 0x0b, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x33, 0x0a, 0x13, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x05, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x52, 0x05, 0x73,
+	0x63, 0x65, 0x6e, 0x65, 0x22, 0x21, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54,
+	0x79, 0x70, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0xba, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x1a, 0x3a, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x63,
+	0x65, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x37, 0x0a, 0x17, 0x47, 0x65,
 	0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x18, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x52, 0x05, 0x73, 0x63,
+	0x65, 0x6e, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2d,
+	0x0a, 0x0f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x32,
+	0x64, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x78, 0x12,
+	0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x79, 0x22, 0xfd, 0x01,
+	0x0a, 0x18, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65,
 	0x72, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x48, 0x00, 0x52, 0x18, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x47, 0x65, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66,
-	0x0a, 0x1d, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x69,
-	0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x47,
-	0x65, 0x74, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x1d, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72,
-	0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x20, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65,
-	0x72, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x21, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72,
-	0x6b, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x20, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x47, 0x65,
-	0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0x0e, 0x0a, 0x0c, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x0d, 0x0a, 0x0b, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x33, 0x0a, 0x13, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x63, 0x65, 0x6e,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x05, 0x73, 0x63, 0x65, 0x6e,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x52,
-	0x05, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x22, 0x21, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5a, 0x0a, 0x10, 0x47, 0x65, 0x74,
-	0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
-	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61,
-	0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69,
-	0x61, 0x54, 0x79, 0x70, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62, 0x6c,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xba, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x54, 0x61, 0x62,
-	0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x47, 0x65,
-	0x74, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f,
-	0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x1a, 0x3a, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x53, 0x63, 0x65, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x37, 0x0a, 0x17,
-	0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x05, 0x73, 0x63, 0x65, 0x6e, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x52, 0x05,
-	0x73, 0x63, 0x65, 0x6e, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x2d, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x56, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x32, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01,
-	0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x79, 0x22,
-	0xbf, 0x01, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x47, 0x65, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x54, 0x72, 0x61, 0x63,
-	0x6b, 0x65, 0x72, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x37, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x63,
+	0x73, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x6d,
+	0x65, 0x72, 0x61, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x53, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x63,
 	0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x44, 0x4c, 0x45,
 	0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x41, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x54, 0x49, 0x4e,
 	0x47, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x52, 0x41, 0x43, 0x4b, 0x49, 0x4e, 0x47, 0x10,
-	0x02, 0x22, 0x17, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x65, 0x74, 0x49,
-	0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a, 0x1e, 0x54, 0x72,
-	0x61, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x07,
-	0x63, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
-	0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x32, 0x64, 0x52,
-	0x07, 0x63, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x73, 0x22, 0x1e, 0x0a, 0x1c, 0x54, 0x72, 0x61, 0x63,
-	0x6b, 0x65, 0x72, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x7f, 0x0a, 0x1d, 0x54, 0x72, 0x61, 0x63,
-	0x6b, 0x65, 0x72, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x6f, 0x75,
-	0x6e, 0x64, 0x43, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52,
-	0x0c, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x3a, 0x0a,
-	0x0f, 0x63, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72,
-	0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x32, 0x64, 0x52, 0x0f, 0x63, 0x6f, 0x72, 0x6e, 0x65, 0x72,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x41, 0x0a, 0x1b, 0x54, 0x72, 0x61,
-	0x63, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4d, 0x73, 0x22, 0x21, 0x0a, 0x1f,
-	0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0xda, 0x01, 0x0a, 0x20, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x47, 0x65, 0x74, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e,
-	0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x4c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x54, 0x0a, 0x14, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72,
+	0x02, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x41, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47,
+	0x5f, 0x49, 0x4e, 0x54, 0x52, 0x49, 0x4e, 0x53, 0x49, 0x43, 0x53, 0x10, 0x03, 0x22, 0x17, 0x0a,
+	0x15, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x65, 0x74, 0x49, 0x64, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6a, 0x0a, 0x1e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x07, 0x63, 0x6f, 0x72, 0x6e,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x72, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x32, 0x64, 0x52, 0x07, 0x63, 0x6f, 0x72,
+	0x6e, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x49,
+	0x64, 0x73, 0x22, 0x1e, 0x0a, 0x1c, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x47, 0x65, 0x74,
+	0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x96, 0x04, 0x0a, 0x1d, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x47, 0x65,
+	0x74, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x72,
+	0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0c, 0x66, 0x6f, 0x75, 0x6e,
+	0x64, 0x43, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x3a, 0x0a, 0x0f, 0x63, 0x6f, 0x72, 0x6e,
+	0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x56, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x32, 0x64, 0x52, 0x0f, 0x63, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x11, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x08, 0x52, 0x07, 0x69, 0x6e, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x10,
+	0x63, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x01, 0x52, 0x10, 0x63, 0x6f, 0x72, 0x6e, 0x65, 0x72, 0x44, 0x65,
+	0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x48, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x65, 0x72, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x61, 0x6c, 0x69,
+	0x62, 0x72, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x05, 0x52, 0x11, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x65, 0x64, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x69, 0x64, 0x75,
+	0x61, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x01, 0x52, 0x09, 0x72, 0x65, 0x73, 0x69, 0x64,
+	0x75, 0x61, 0x6c, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x6e, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x63,
+	0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x12, 0x6e, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x35, 0x0a, 0x11, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e,
+	0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x41, 0x53, 0x53, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x22, 0xc7, 0x03, 0x0a, 0x1b,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4d, 0x73, 0x12,
+	0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x6c, 0x6f, 0x73, 0x74, 0x47, 0x72, 0x61, 0x63, 0x65,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x11,
+	0x6c, 0x6f, 0x73, 0x74, 0x47, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4d,
+	0x73, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x54, 0x6f,
+	0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x64,
+	0x65, 0x6c, 0x74, 0x61, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2a, 0x0a,
+	0x10, 0x6b, 0x65, 0x79, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x6b, 0x65, 0x79, 0x66, 0x72, 0x61, 0x6d,
+	0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x3d, 0x0a, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x6c, 0x65,
+	0x64, 0x50, 0x61, 0x69, 0x72, 0x48, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0f, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x69, 0x72, 0x48, 0x65, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x22, 0x2f, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x45, 0x44, 0x5f, 0x4d, 0x41, 0x52, 0x4b,
+	0x45, 0x52, 0x53, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x52, 0x55, 0x43, 0x4f, 0x5f, 0x54,
+	0x41, 0x47, 0x53, 0x10, 0x01, 0x22, 0x21, 0x0a, 0x1f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72,
+	0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9f, 0x04, 0x0a, 0x20, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x72, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a,
+	0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72,
+	0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x57, 0x0a, 0x0c, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x47,
+	0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x43,
+	0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x12, 0x48, 0x0a, 0x07, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x72, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x72, 0x73, 0x1a, 0x54, 0x0a, 0x14, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x72, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x32, 0x64, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x54, 0x0a, 0x11, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
+	0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4a,
+	0x0a, 0x0c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x81, 0x05, 0x0a, 0x22, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x62, 0x0a, 0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x59, 0x0a, 0x0c, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x43,
+	0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0c, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x73,
+	0x12, 0x4a, 0x0a, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x30, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x66,
+	0x72, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x66,
+	0x72, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x4b,
+	0x65, 0x79, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x1a, 0x54, 0x0a, 0x14, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72,
 	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
 	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79,
 	0x12, 0x26, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x10, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x32,
-	0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xde, 0x01, 0x0a,
-	0x22, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x62, 0x0a, 0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x4c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x54,
-	0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b,
-	0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x54, 0x0a, 0x14, 0x4d, 0x61, 0x72, 0x6b, 0x65,
-	0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x54, 0x0a, 0x11,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x4a, 0x0a, 0x0c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x1d,
+	0x0a, 0x1b, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xed, 0x01,
+	0x0a, 0x1c, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x65, 0x74, 0x44, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22,
+	0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x72, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x32, 0x64, 0x52, 0x0d, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x32, 0x0a, 0x0b, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x32,
+	0x64, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x3d,
+	0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x79, 0x67,
+	0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x52, 0x11, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x73, 0x22, 0xee, 0x02,
+	0x0a, 0x24, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x65, 0x74, 0x44, 0x65, 0x74, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x42, 0x72, 0x69,
+	0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x6d,
+	0x69, 0x6e, 0x42, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0e,
+	0x61, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x61, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x4f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x11, 0x61, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x36, 0x0a, 0x16, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x16, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4c, 0x65,
+	0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x66, 0x6f,
+	0x72, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x13, 0x66, 0x6f, 0x72, 0x65, 0x67, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x36, 0x0a, 0x16,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x42, 0x61, 0x63,
+	0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x3e,
+	0x0a, 0x0e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e,
+	0x12, 0x2c, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x74, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x56, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x32, 0x64, 0x52, 0x08, 0x76, 0x65, 0x72, 0x74, 0x69, 0x63, 0x65, 0x73, 0x22, 0xb4,
+	0x04, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72,
+	0x12, 0x2c, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x56, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x32, 0x64, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c,
+	0x0a, 0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x10, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x32, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x32, 0x5a,
-	0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x75, 0x74, 0x6d,
-	0x61, 0x6e, 0x39, 0x36, 0x2f, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x2e,
-	0x69, 0x6f, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x32, 0x64, 0x52, 0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x05,
+	0x70, 0x69, 0x78, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x72, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x32, 0x64, 0x52, 0x05, 0x70,
+	0x69, 0x78, 0x65, 0x6c, 0x12, 0x29, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x61,
+	0x72, 0x65, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x63, 0x69, 0x72, 0x63, 0x75, 0x6c,
+	0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e,
+	0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x62, 0x72, 0x69, 0x67, 0x68,
+	0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x73, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x61, 0x73, 0x48, 0x65, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x48, 0x65, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x11, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x11,
+	0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x42, 0x0a, 0x0d, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x65, 0x72, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0d, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x4b,
+	0x65, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64,
+	0x4b, 0x65, 0x79, 0x22, 0x40, 0x0a, 0x0d, 0x48, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x07,
+	0x0a, 0x03, 0x54, 0x41, 0x47, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x45, 0x44, 0x5f, 0x50,
+	0x41, 0x49, 0x52, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x4c, 0x4f, 0x4e, 0x47, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0x03, 0x22, 0x49, 0x0a, 0x1a, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0xcd, 0x01, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x06,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x06, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x72, 0x22, 0x4a, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x50, 0x50, 0x45, 0x41, 0x52, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x09, 0x0a, 0x05, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x4f,
+	0x53, 0x54, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x45,
+	0x44, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x04,
+	0x22, 0x77, 0x0a, 0x27, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x49, 0x6e, 0x74, 0x72, 0x69, 0x6e, 0x73, 0x69, 0x63, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x71, 0x75,
+	0x61, 0x72, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x73,
+	0x71, 0x75, 0x61, 0x72, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x27, 0x0a, 0x25, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x72, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x72, 0x69, 0x6e, 0x73, 0x69, 0x63,
+	0x43, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0xc2, 0x01, 0x0a, 0x26, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x47, 0x65,
+	0x74, 0x49, 0x6e, 0x74, 0x72, 0x69, 0x6e, 0x73, 0x69, 0x63, 0x43, 0x61, 0x6c, 0x69, 0x62, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x74, 0x65, 0x64, 0x12, 0x2c, 0x0a,
+	0x11, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x76,
+	0x69, 0x65, 0x77, 0x73, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x76, 0x69, 0x65, 0x77, 0x73, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65,
+	0x64, 0x12, 0x24, 0x0a, 0x0d, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x2a, 0x57, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x65, 0x72, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x11, 0x0a,
+	0x0d, 0x43, 0x4f, 0x4c, 0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
+	0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4c, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x0f, 0x0a, 0x0b, 0x43, 0x4f, 0x4c, 0x4f, 0x52, 0x5f, 0x47, 0x52, 0x45, 0x45, 0x4e, 0x10, 0x02,
+	0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x4c, 0x4f, 0x52, 0x5f, 0x42, 0x4c, 0x55, 0x45, 0x10, 0x03,
+	0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74,
+	0x75, 0x74, 0x6d, 0x61, 0x6e, 0x39, 0x36, 0x2f, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x73, 0x69,
+	0x73, 0x74, 0x2e, 0x69, 0x6f, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1762,75 +3382,123 @@ func file_protos_external_proto_rawDescGZIP() []byte {
 	return file_protos_external_proto_rawDescData
 }
 
-var file_protos_external_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_protos_external_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_protos_external_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_protos_external_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_protos_external_proto_goTypes = []interface{}{
-	(TrackerGetStatusResponse_TrackerState)(0), // 0: TrackerGetStatusResponse.TrackerState
-	(*Packet)(nil),                                   // 1: Packet
-	(*Request)(nil),                                  // 2: Request
-	(*Response)(nil),                                 // 3: Response
-	(*HelloRequest)(nil),                             // 4: HelloRequest
-	(*AckResponse)(nil),                              // 5: AckResponse
-	(*DisplaySceneRequest)(nil),                      // 6: DisplaySceneRequest
-	(*GetAssetRequest)(nil),                          // 7: GetAssetRequest
-	(*GetAssetResponse)(nil),                         // 8: GetAssetResponse
-	(*GetTableConfigurationRequest)(nil),             // 9: GetTableConfigurationRequest
-	(*GetTableConfigurationResponse)(nil),            // 10: GetTableConfigurationResponse
-	(*GetCurrentSceneRequest)(nil),                   // 11: GetCurrentSceneRequest
-	(*GetCurrentSceneResponse)(nil),                  // 12: GetCurrentSceneResponse
-	(*TrackerGetStatusRequest)(nil),                  // 13: TrackerGetStatusRequest
-	(*TrackerVector2D)(nil),                          // 14: TrackerVector2d
-	(*TrackerGetStatusResponse)(nil),                 // 15: TrackerGetStatusResponse
-	(*TrackerSetIdleRequest)(nil),                    // 16: TrackerSetIdleRequest
-	(*TrackerStartCalibrationRequest)(nil),           // 17: TrackerStartCalibrationRequest
-	(*TrackerGetCalibrationRequest)(nil),             // 18: TrackerGetCalibrationRequest
-	(*TrackerGetCalibrationResponse)(nil),            // 19: TrackerGetCalibrationResponse
-	(*TrackerStartTrackingRequest)(nil),              // 20: TrackerStartTrackingRequest
-	(*TrackerGetMarkerLocationRequest)(nil),          // 21: TrackerGetMarkerLocationRequest
-	(*TrackerGetMarkerLocationResponse)(nil),         // 22: TrackerGetMarkerLocationResponse
-	(*TrackerUpdateMarkerLocationRequest)(nil),       // 23: TrackerUpdateMarkerLocationRequest
-	(*GetTableConfigurationResponse_Resolution)(nil), // 24: GetTableConfigurationResponse.Resolution
-	nil,           // 25: TrackerGetMarkerLocationResponse.MarkerLocationsEntry
-	nil,           // 26: TrackerUpdateMarkerLocationRequest.MarkerLocationsEntry
-	(*Scene)(nil), // 27: Scene
+	(TrackerMarkerColor)(0),                              // 0: TrackerMarkerColor
+	(TrackerGetStatusResponse_TrackerState)(0),           // 1: TrackerGetStatusResponse.TrackerState
+	(TrackerGetCalibrationResponse_CalibrationResult)(0), // 2: TrackerGetCalibrationResponse.CalibrationResult
+	(TrackerStartTrackingRequest_TrackingMode)(0),        // 3: TrackerStartTrackingRequest.TrackingMode
+	(TrackerMarker_HeadingSource)(0),                     // 4: TrackerMarker.HeadingSource
+	(TrackerMarkerEvent_EventType)(0),                    // 5: TrackerMarkerEvent.EventType
+	(*Packet)(nil),                                       // 6: Packet
+	(*Request)(nil),                                      // 7: Request
+	(*Response)(nil),                                     // 8: Response
+	(*HelloRequest)(nil),                                 // 9: HelloRequest
+	(*AckResponse)(nil),                                  // 10: AckResponse
+	(*DisplaySceneRequest)(nil),                          // 11: DisplaySceneRequest
+	(*GetAssetRequest)(nil),                              // 12: GetAssetRequest
+	(*GetAssetResponse)(nil),                             // 13: GetAssetResponse
+	(*GetTableConfigurationRequest)(nil),                 // 14: GetTableConfigurationRequest
+	(*GetTableConfigurationResponse)(nil),                // 15: GetTableConfigurationResponse
+	(*GetCurrentSceneRequest)(nil),                       // 16: GetCurrentSceneRequest
+	(*GetCurrentSceneResponse)(nil),                      // 17: GetCurrentSceneResponse
+	(*TrackerGetStatusRequest)(nil),                      // 18: TrackerGetStatusRequest
+	(*TrackerVector2D)(nil),                              // 19: TrackerVector2d
+	(*TrackerGetStatusResponse)(nil),                     // 20: TrackerGetStatusResponse
+	(*TrackerSetIdleRequest)(nil),                        // 21: TrackerSetIdleRequest
+	(*TrackerStartCalibrationRequest)(nil),               // 22: TrackerStartCalibrationRequest
+	(*TrackerGetCalibrationRequest)(nil),                 // 23: TrackerGetCalibrationRequest
+	(*TrackerGetCalibrationResponse)(nil),                // 24: TrackerGetCalibrationResponse
+	(*TrackerStartTrackingRequest)(nil),                  // 25: TrackerStartTrackingRequest
+	(*TrackerGetMarkerLocationRequest)(nil),              // 26: TrackerGetMarkerLocationRequest
+	(*TrackerGetMarkerLocationResponse)(nil),             // 27: TrackerGetMarkerLocationResponse
+	(*TrackerUpdateMarkerLocationRequest)(nil),           // 28: TrackerUpdateMarkerLocationRequest
+	(*TrackerResyncMarkersRequest)(nil),                  // 29: TrackerResyncMarkersRequest
+	(*TrackerSetDisplayMaskRequest)(nil),                 // 30: TrackerSetDisplayMaskRequest
+	(*TrackerSetDetectionParametersRequest)(nil),         // 31: TrackerSetDetectionParametersRequest
+	(*TrackerPolygon)(nil),                               // 32: TrackerPolygon
+	(*TrackerMarker)(nil),                                // 33: TrackerMarker
+	(*TrackerMarkerEventsRequest)(nil),                   // 34: TrackerMarkerEventsRequest
+	(*TrackerMarkerEvent)(nil),                           // 35: TrackerMarkerEvent
+	(*TrackerStartIntrinsicCalibrationRequest)(nil),      // 36: TrackerStartIntrinsicCalibrationRequest
+	(*TrackerGetIntrinsicCalibrationRequest)(nil),        // 37: TrackerGetIntrinsicCalibrationRequest
+	(*TrackerGetIntrinsicCalibrationResponse)(nil),       // 38: TrackerGetIntrinsicCalibrationResponse
+	(*GetTableConfigurationResponse_Resolution)(nil),     // 39: GetTableConfigurationResponse.Resolution
+	nil,           // 40: TrackerGetMarkerLocationResponse.MarkerLocationsEntry
+	nil,           // 41: TrackerGetMarkerLocationResponse.MarkerColorsEntry
+	nil,           // 42: TrackerGetMarkerLocationResponse.MarkersEntry
+	nil,           // 43: TrackerUpdateMarkerLocationRequest.MarkerLocationsEntry
+	nil,           // 44: TrackerUpdateMarkerLocationRequest.MarkerColorsEntry
+	nil,           // 45: TrackerUpdateMarkerLocationRequest.MarkersEntry
+	(*Scene)(nil), // 46: Scene
 }
 var file_protos_external_proto_depIdxs = []int32{
-	2,  // 0: Packet.request:type_name -> Request
-	3,  // 1: Packet.response:type_name -> Response
-	4,  // 2: Request.helloRequest:type_name -> HelloRequest
-	6,  // 3: Request.displaySceneRequest:type_name -> DisplaySceneRequest
-	7,  // 4: Request.getAssetRequest:type_name -> GetAssetRequest
-	9,  // 5: Request.getTableConfigurationRequest:type_name -> GetTableConfigurationRequest
-	11, // 6: Request.getCurrentSceneRequest:type_name -> GetCurrentSceneRequest
-	13, // 7: Request.trackerGetStatusRequest:type_name -> TrackerGetStatusRequest
-	16, // 8: Request.trackerSetIdleRequest:type_name -> TrackerSetIdleRequest
-	17, // 9: Request.trackerStartCalibrationRequest:type_name -> TrackerStartCalibrationRequest
-	18, // 10: Request.trackerGetCalibrationRequest:type_name -> TrackerGetCalibrationRequest
-	20, // 11: Request.trackerStartTrackingRequest:type_name -> TrackerStartTrackingRequest
-	21, // 12: Request.trackerGetMarkerLocationRequest:type_name -> TrackerGetMarkerLocationRequest
-	23, // 13: Request.trackerUpdateMarkerLocationRequest:type_name -> TrackerUpdateMarkerLocationRequest
-	5,  // 14: Response.ackResponse:type_name -> AckResponse
-	8,  // 15: Response.getAssetResponse:type_name -> GetAssetResponse
-	10, // 16: Response.getTableConfigurationResponse:type_name -> GetTableConfigurationResponse
-	12, // 17: Response.getCurrentSceneResponse:type_name -> GetCurrentSceneResponse
-	15, // 18: Response.trackerGetStatusResponse:type_name -> TrackerGetStatusResponse
-	19, // 19: Response.trackerGetCalibrationResponse:type_name -> TrackerGetCalibrationResponse
-	22, // 20: Response.trackerGetMarkerLocationResponse:type_name -> TrackerGetMarkerLocationResponse
-	27, // 21: DisplaySceneRequest.scene:type_name -> Scene
-	24, // 22: GetTableConfigurationResponse.resolution:type_name -> GetTableConfigurationResponse.Resolution
-	27, // 23: GetCurrentSceneResponse.scene:type_name -> Scene
-	0,  // 24: TrackerGetStatusResponse.state:type_name -> TrackerGetStatusResponse.TrackerState
-	14, // 25: TrackerStartCalibrationRequest.corners:type_name -> TrackerVector2d
-	14, // 26: TrackerGetCalibrationResponse.cornerLocations:type_name -> TrackerVector2d
-	25, // 27: TrackerGetMarkerLocationResponse.markerLocations:type_name -> TrackerGetMarkerLocationResponse.MarkerLocationsEntry
-	26, // 28: TrackerUpdateMarkerLocationRequest.markerLocations:type_name -> TrackerUpdateMarkerLocationRequest.MarkerLocationsEntry
-	14, // 29: TrackerGetMarkerLocationResponse.MarkerLocationsEntry.value:type_name -> TrackerVector2d
-	14, // 30: TrackerUpdateMarkerLocationRequest.MarkerLocationsEntry.value:type_name -> TrackerVector2d
-	31, // [31:31] is the sub-list for method output_type
-	31, // [31:31] is the sub-list for method input_type
-	31, // [31:31] is the sub-list for extension type_name
-	31, // [31:31] is the sub-list for extension extendee
-	0,  // [0:31] is the sub-list for field type_name
+	7,  // 0: Packet.request:type_name -> Request
+	8,  // 1: Packet.response:type_name -> Response
+	9,  // 2: Request.helloRequest:type_name -> HelloRequest
+	11, // 3: Request.displaySceneRequest:type_name -> DisplaySceneRequest
+	12, // 4: Request.getAssetRequest:type_name -> GetAssetRequest
+	14, // 5: Request.getTableConfigurationRequest:type_name -> GetTableConfigurationRequest
+	16, // 6: Request.getCurrentSceneRequest:type_name -> GetCurrentSceneRequest
+	18, // 7: Request.trackerGetStatusRequest:type_name -> TrackerGetStatusRequest
+	21, // 8: Request.trackerSetIdleRequest:type_name -> TrackerSetIdleRequest
+	22, // 9: Request.trackerStartCalibrationRequest:type_name -> TrackerStartCalibrationRequest
+	23, // 10: Request.trackerGetCalibrationRequest:type_name -> TrackerGetCalibrationRequest
+	25, // 11: Request.trackerStartTrackingRequest:type_name -> TrackerStartTrackingRequest
+	26, // 12: Request.trackerGetMarkerLocationRequest:type_name -> TrackerGetMarkerLocationRequest
+	28, // 13: Request.trackerUpdateMarkerLocationRequest:type_name -> TrackerUpdateMarkerLocationRequest
+	36, // 14: Request.trackerStartIntrinsicCalibrationRequest:type_name -> TrackerStartIntrinsicCalibrationRequest
+	37, // 15: Request.trackerGetIntrinsicCalibrationRequest:type_name -> TrackerGetIntrinsicCalibrationRequest
+	34, // 16: Request.trackerMarkerEventsRequest:type_name -> TrackerMarkerEventsRequest
+	29, // 17: Request.trackerResyncMarkersRequest:type_name -> TrackerResyncMarkersRequest
+	30, // 18: Request.trackerSetDisplayMaskRequest:type_name -> TrackerSetDisplayMaskRequest
+	31, // 19: Request.trackerSetDetectionParametersRequest:type_name -> TrackerSetDetectionParametersRequest
+	10, // 20: Response.ackResponse:type_name -> AckResponse
+	13, // 21: Response.getAssetResponse:type_name -> GetAssetResponse
+	15, // 22: Response.getTableConfigurationResponse:type_name -> GetTableConfigurationResponse
+	17, // 23: Response.getCurrentSceneResponse:type_name -> GetCurrentSceneResponse
+	20, // 24: Response.trackerGetStatusResponse:type_name -> TrackerGetStatusResponse
+	24, // 25: Response.trackerGetCalibrationResponse:type_name -> TrackerGetCalibrationResponse
+	27, // 26: Response.trackerGetMarkerLocationResponse:type_name -> TrackerGetMarkerLocationResponse
+	38, // 27: Response.trackerGetIntrinsicCalibrationResponse:type_name -> TrackerGetIntrinsicCalibrationResponse
+	46, // 28: DisplaySceneRequest.scene:type_name -> Scene
+	39, // 29: GetTableConfigurationResponse.resolution:type_name -> GetTableConfigurationResponse.Resolution
+	46, // 30: GetCurrentSceneResponse.scene:type_name -> Scene
+	1,  // 31: TrackerGetStatusResponse.state:type_name -> TrackerGetStatusResponse.TrackerState
+	19, // 32: TrackerStartCalibrationRequest.corners:type_name -> TrackerVector2d
+	19, // 33: TrackerGetCalibrationResponse.cornerLocations:type_name -> TrackerVector2d
+	2,  // 34: TrackerGetCalibrationResponse.result:type_name -> TrackerGetCalibrationResponse.CalibrationResult
+	3,  // 35: TrackerStartTrackingRequest.mode:type_name -> TrackerStartTrackingRequest.TrackingMode
+	40, // 36: TrackerGetMarkerLocationResponse.markerLocations:type_name -> TrackerGetMarkerLocationResponse.MarkerLocationsEntry
+	41, // 37: TrackerGetMarkerLocationResponse.markerColors:type_name -> TrackerGetMarkerLocationResponse.MarkerColorsEntry
+	42, // 38: TrackerGetMarkerLocationResponse.markers:type_name -> TrackerGetMarkerLocationResponse.MarkersEntry
+	43, // 39: TrackerUpdateMarkerLocationRequest.markerLocations:type_name -> TrackerUpdateMarkerLocationRequest.MarkerLocationsEntry
+	44, // 40: TrackerUpdateMarkerLocationRequest.markerColors:type_name -> TrackerUpdateMarkerLocationRequest.MarkerColorsEntry
+	45, // 41: TrackerUpdateMarkerLocationRequest.markers:type_name -> TrackerUpdateMarkerLocationRequest.MarkersEntry
+	19, // 42: TrackerSetDisplayMaskRequest.displayOrigin:type_name -> TrackerVector2d
+	19, // 43: TrackerSetDisplayMaskRequest.displaySize:type_name -> TrackerVector2d
+	32, // 44: TrackerSetDisplayMaskRequest.exclusionPolygons:type_name -> TrackerPolygon
+	19, // 45: TrackerPolygon.vertices:type_name -> TrackerVector2d
+	19, // 46: TrackerMarker.location:type_name -> TrackerVector2d
+	19, // 47: TrackerMarker.velocity:type_name -> TrackerVector2d
+	19, // 48: TrackerMarker.pixel:type_name -> TrackerVector2d
+	0,  // 49: TrackerMarker.color:type_name -> TrackerMarkerColor
+	4,  // 50: TrackerMarker.headingSource:type_name -> TrackerMarker.HeadingSource
+	35, // 51: TrackerMarkerEventsRequest.events:type_name -> TrackerMarkerEvent
+	5,  // 52: TrackerMarkerEvent.type:type_name -> TrackerMarkerEvent.EventType
+	33, // 53: TrackerMarkerEvent.marker:type_name -> TrackerMarker
+	19, // 54: TrackerGetMarkerLocationResponse.MarkerLocationsEntry.value:type_name -> TrackerVector2d
+	0,  // 55: TrackerGetMarkerLocationResponse.MarkerColorsEntry.value:type_name -> TrackerMarkerColor
+	33, // 56: TrackerGetMarkerLocationResponse.MarkersEntry.value:type_name -> TrackerMarker
+	19, // 57: TrackerUpdateMarkerLocationRequest.MarkerLocationsEntry.value:type_name -> TrackerVector2d
+	0,  // 58: TrackerUpdateMarkerLocationRequest.MarkerColorsEntry.value:type_name -> TrackerMarkerColor
+	33, // 59: TrackerUpdateMarkerLocationRequest.MarkersEntry.value:type_name -> TrackerMarker
+	60, // [60:60] is the sub-list for method output_type
+	60, // [60:60] is the sub-list for method input_type
+	60, // [60:60] is the sub-list for extension type_name
+	60, // [60:60] is the sub-list for extension extendee
+	0,  // [0:60] is the sub-list for field type_name
 }
 
 func init() { file_protos_external_proto_init() }
@@ -2117,6 +3785,126 @@ func file_protos_external_proto_init() {
 			}
 		}
 		file_protos_external_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackerResyncMarkersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_external_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackerSetDisplayMaskRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_external_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackerSetDetectionParametersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_external_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackerPolygon); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_external_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackerMarker); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_external_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackerMarkerEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_external_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackerMarkerEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_external_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackerStartIntrinsicCalibrationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_external_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackerGetIntrinsicCalibrationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_external_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackerGetIntrinsicCalibrationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_external_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTableConfigurationResponse_Resolution); i {
 			case 0:
 				return &v.state
@@ -2146,6 +3934,12 @@ func file_protos_external_proto_init() {
 		(*Request_TrackerStartTrackingRequest)(nil),
 		(*Request_TrackerGetMarkerLocationRequest)(nil),
 		(*Request_TrackerUpdateMarkerLocationRequest)(nil),
+		(*Request_TrackerStartIntrinsicCalibrationRequest)(nil),
+		(*Request_TrackerGetIntrinsicCalibrationRequest)(nil),
+		(*Request_TrackerMarkerEventsRequest)(nil),
+		(*Request_TrackerResyncMarkersRequest)(nil),
+		(*Request_TrackerSetDisplayMaskRequest)(nil),
+		(*Request_TrackerSetDetectionParametersRequest)(nil),
 	}
 	file_protos_external_proto_msgTypes[2].OneofWrappers = []interface{}{
 		(*Response_AckResponse)(nil),
@@ -2155,14 +3949,15 @@ func file_protos_external_proto_init() {
 		(*Response_TrackerGetStatusResponse)(nil),
 		(*Response_TrackerGetCalibrationResponse)(nil),
 		(*Response_TrackerGetMarkerLocationResponse)(nil),
+		(*Response_TrackerGetIntrinsicCalibrationResponse)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protos_external_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   26,
+			NumEnums:      6,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   0,
 		},